@@ -0,0 +1,54 @@
+package ebayapi
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+)
+
+//
+// Every ebay application must subscribe to MARKETPLACE_ACCOUNT_DELETION notifications and purge the personal
+// data it holds for the closed account: https://developer.ebay.com/marketplace-account-deletion
+//
+
+// TopicMarketplaceAccountDeletion is the Notification API topic for account deletion/closure
+const TopicMarketplaceAccountDeletion = "MARKETPLACE_ACCOUNT_DELETION"
+
+// AccountDeletionData is the payload of a MARKETPLACE_ACCOUNT_DELETION notification
+type AccountDeletionData struct {
+	Username  string `json:"username"`
+	UserID    string `json:"userId"`
+	EIASToken string `json:"eiasToken"`
+}
+
+// AccountDeletionFunc purges the data held for the deleted account. Returning nil acknowledges the
+// notification, returning an error makes ebay deliver it again later
+type AccountDeletionFunc func(ctx context.Context, n *CommerceNotification, data *AccountDeletionData) error
+
+// NewAccountDeletionHandler instantiates a webhook handler answering ebay's endpoint challenge and passing
+// verified account deletion notifications to purge
+func NewAccountDeletionHandler(keys NotificationKeyFetcher, endpoint, verificationToken string, purge AccountDeletionFunc, l *logrus.Logger) *CommerceNotificationHandler {
+	h := NewCommerceNotificationHandler(keys, endpoint, verificationToken, l)
+	h.HandleAccountDeletion(purge)
+	return h
+}
+
+// HandleAccountDeletion registers purge as the MARKETPLACE_ACCOUNT_DELETION callback
+func (h *CommerceNotificationHandler) HandleAccountDeletion(purge AccountDeletionFunc) {
+	h.Handle(TopicMarketplaceAccountDeletion, func(ctx context.Context, n *CommerceNotification) error {
+		// Acknowledge notifications without a userId, failing them would make ebay redeliver them forever
+		// and flag the endpoint
+		data, ok := n.Payload.(*AccountDeletionData)
+		if !ok || data.UserID == "" {
+			if h.logger != nil {
+				h.logger.Warn("IGNORED account deletion notification without userId: ", n.Notification.NotificationID)
+			}
+			return nil
+		}
+
+		if h.logger != nil {
+			h.logger.Info("PURGING ebay account data: ", data.UserID)
+		}
+		return purge(ctx, n, data)
+	})
+}
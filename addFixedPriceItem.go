@@ -0,0 +1,84 @@
+package ebayapi
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// AddFixedPriceItemRequest struct
+type AddFixedPriceItemRequest struct {
+	XMLName              xml.Name
+	RequesterCredentials *RequesterCredentials
+	Item                 *Item
+	ErrorLanguage        string `xml:",omitempty"`
+	MessageID            string `xml:",omitempty"`
+	Version              string `xml:",omitempty"`
+	WarningLevel         string `xml:",omitempty"`
+}
+
+// CallName returns name of call
+func (rq AddFixedPriceItemRequest) CallName() string {
+	return "AddFixedPriceItem"
+}
+
+// Body ataches credential and returns XML body
+func (rq AddFixedPriceItemRequest) Body(creds *Credentials) interface{} {
+	rq.XMLName = xml.Name{
+		Space: "urn:ebay:apis:eBLBaseComponents",
+		Local: rq.CallName(),
+	}
+	rq.RequesterCredentials = &RequesterCredentials{EBayAuthToken: creds.AuthToken}
+	return rq
+}
+
+// ParseResponse retruns response data as EbayResponse object
+func (rq AddFixedPriceItemRequest) ParseResponse(resp []byte) (EbayResponse, error) {
+	var xmlResponse AddFixedPriceItemResponse
+	err := xml.Unmarshal(resp, &xmlResponse)
+
+	return xmlResponse, err
+}
+
+// VerifyAddFixedPriceItemRequest validates an AddFixedPriceItem request and returns its fees without listing
+type VerifyAddFixedPriceItemRequest AddFixedPriceItemRequest
+
+// CallName returns name of call
+func (rq VerifyAddFixedPriceItemRequest) CallName() string {
+	return "VerifyAddFixedPriceItem"
+}
+
+// Body ataches credential and returns XML body
+func (rq VerifyAddFixedPriceItemRequest) Body(creds *Credentials) interface{} {
+	rq.XMLName = xml.Name{
+		Space: "urn:ebay:apis:eBLBaseComponents",
+		Local: rq.CallName(),
+	}
+	rq.RequesterCredentials = &RequesterCredentials{EBayAuthToken: creds.AuthToken}
+	return rq
+}
+
+// ParseResponse retruns response data as EbayResponse object
+func (rq VerifyAddFixedPriceItemRequest) ParseResponse(resp []byte) (EbayResponse, error) {
+	var xmlResponse AddFixedPriceItemResponse
+	err := xml.Unmarshal(resp, &xmlResponse)
+
+	return xmlResponse, err
+}
+
+// ResponseErrors returns errors
+func (rs AddFixedPriceItemResponse) ResponseErrors() EbayErrors {
+	return rs.ebayResponse.Errors
+}
+
+// AddFixedPriceItemResponse struct - also returned by VerifyAddFixedPriceItem, without ItemID and times
+type AddFixedPriceItemResponse struct {
+	ebayResponse
+	ItemID         string
+	SKU            string
+	StartTime      *time.Time
+	EndTime        *time.Time
+	CategoryID     string
+	Category2ID    string
+	DiscountReason []string
+	Fees           Fees
+}
@@ -0,0 +1,112 @@
+package ebayapi
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// AddItemRequest struct - lists auctions and single quantity fixed price items
+type AddItemRequest struct {
+	XMLName              xml.Name
+	RequesterCredentials *RequesterCredentials
+	Item                 *Item
+	ErrorLanguage        string `xml:",omitempty"`
+	MessageID            string `xml:",omitempty"`
+	Version              string `xml:",omitempty"`
+	WarningLevel         string `xml:",omitempty"`
+}
+
+// CallName returns name of call
+func (rq AddItemRequest) CallName() string {
+	return "AddItem"
+}
+
+// Body ataches credential and returns XML body
+func (rq AddItemRequest) Body(creds *Credentials) interface{} {
+	rq.XMLName = xml.Name{
+		Space: "urn:ebay:apis:eBLBaseComponents",
+		Local: rq.CallName(),
+	}
+	rq.RequesterCredentials = &RequesterCredentials{EBayAuthToken: creds.AuthToken}
+	return rq
+}
+
+// ParseResponse retruns response data as EbayResponse object
+func (rq AddItemRequest) ParseResponse(resp []byte) (EbayResponse, error) {
+	var xmlResponse AddItemResponse
+	err := xml.Unmarshal(resp, &xmlResponse)
+
+	return xmlResponse, err
+}
+
+// VerifyAddItemRequest validates an AddItem request and returns its fees without listing
+type VerifyAddItemRequest AddItemRequest
+
+// CallName returns name of call
+func (rq VerifyAddItemRequest) CallName() string {
+	return "VerifyAddItem"
+}
+
+// Body ataches credential and returns XML body
+func (rq VerifyAddItemRequest) Body(creds *Credentials) interface{} {
+	rq.XMLName = xml.Name{
+		Space: "urn:ebay:apis:eBLBaseComponents",
+		Local: rq.CallName(),
+	}
+	rq.RequesterCredentials = &RequesterCredentials{EBayAuthToken: creds.AuthToken}
+	return rq
+}
+
+// ParseResponse retruns response data as EbayResponse object
+func (rq VerifyAddItemRequest) ParseResponse(resp []byte) (EbayResponse, error) {
+	var xmlResponse AddItemResponse
+	err := xml.Unmarshal(resp, &xmlResponse)
+
+	return xmlResponse, err
+}
+
+// ResponseErrors returns errors
+func (rs AddItemResponse) ResponseErrors() EbayErrors {
+	return rs.ebayResponse.Errors
+}
+
+// AddItemResponse struct - also returned by VerifyAddItem, without ItemID and times
+type AddItemResponse struct {
+	ebayResponse
+	ItemID         string
+	StartTime      *time.Time
+	EndTime        *time.Time
+	CategoryID     string
+	Category2ID    string
+	DiscountReason []string
+	Fees           Fees
+}
+
+// Fees type - listing fees returned by the add, verify and relist calls
+type Fees struct {
+	Fee []Fee `xml:"Fee"`
+}
+
+// Fee type
+type Fee struct {
+	Name                string
+	Fee                 Price
+	PromotionalDiscount *Price
+}
+
+// Get returns the named fee, e.g. InsertionFee
+func (f Fees) Get(name string) (Price, bool) {
+	for _, fee := range f.Fee {
+		if fee.Name == name {
+			return fee.Fee, true
+		}
+	}
+
+	return Price{}, false
+}
+
+// Total returns the ListingFee, which ebay reports as the sum of all listing fees
+func (f Fees) Total() Price {
+	total, _ := f.Get("ListingFee")
+	return total
+}
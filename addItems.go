@@ -0,0 +1,84 @@
+package ebayapi
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// MaxAddItemsPerCall is the number of items ebay accepts in a single AddItems call
+const MaxAddItemsPerCall = 5
+
+// AddItemsRequest struct - lists up to 5 items, each correlated to its result by MessageID
+type AddItemsRequest struct {
+	XMLName                 xml.Name
+	RequesterCredentials    *RequesterCredentials
+	AddItemRequestContainer []*AddItemRequestContainer `xml:"AddItemRequestContainer"`
+	ErrorLanguage           string                     `xml:",omitempty"`
+	MessageID               string                     `xml:",omitempty"`
+	Version                 string                     `xml:",omitempty"`
+	WarningLevel            string                     `xml:",omitempty"`
+}
+
+// AddItemRequestContainer struct
+type AddItemRequestContainer struct {
+	Item      *Item
+	MessageID string `xml:",omitempty"`
+}
+
+// CallName returns name of call
+func (rq AddItemsRequest) CallName() string {
+	return "AddItems"
+}
+
+// Body ataches credential and returns XML body
+func (rq AddItemsRequest) Body(creds *Credentials) interface{} {
+	rq.XMLName = xml.Name{
+		Space: "urn:ebay:apis:eBLBaseComponents",
+		Local: rq.CallName(),
+	}
+	rq.RequesterCredentials = &RequesterCredentials{EBayAuthToken: creds.AuthToken}
+	return rq
+}
+
+// ParseResponse retruns response data as EbayResponse object
+func (rq AddItemsRequest) ParseResponse(resp []byte) (EbayResponse, error) {
+	var xmlResponse AddItemsResponse
+	err := xml.Unmarshal(resp, &xmlResponse)
+
+	return xmlResponse, err
+}
+
+// ResponseErrors returns errors
+func (rs AddItemsResponse) ResponseErrors() EbayErrors {
+	return rs.ebayResponse.Errors
+}
+
+// AddItemsResponse struct
+type AddItemsResponse struct {
+	ebayResponse
+	AddItemResponseContainer []AddItemResponseContainer `xml:"AddItemResponseContainer"`
+}
+
+// AddItemResponseContainer struct - CorrelationID echoes the MessageID of the request container
+type AddItemResponseContainer struct {
+	CorrelationID  string
+	ItemID         string
+	StartTime      *time.Time
+	EndTime        *time.Time
+	CategoryID     string
+	Category2ID    string
+	DiscountReason []string
+	Fees           Fees
+	Errors         []ebayResponseError
+}
+
+// Failure checks if the item of this container failed to list
+func (c AddItemResponseContainer) Failure() bool {
+	for _, e := range c.Errors {
+		if e.SeverityCode == "Error" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,147 @@
+package ebayapi
+
+import (
+	"context"
+	"time"
+)
+
+// AutoFeedbackResult is the outcome of leaving feedback for one line item
+type AutoFeedbackResult struct {
+	OrderID       string
+	ItemID        string
+	TransactionID string
+	Buyer         string
+	FeedbackID    string
+	Err           error
+}
+
+// AutoFeedback leaves positive feedback for buyers once their order is paid and delivered
+type AutoFeedback struct {
+	api *TradingAPI
+
+	// Comment is the feedback text, at most 80 characters
+	Comment string
+	// DeliveredFor is how long after delivery feedback is left
+	DeliveredFor time.Duration
+}
+
+// NewAutoFeedback instantiates an AutoFeedback leaving the comment as soon as orders are delivered
+func NewAutoFeedback(api *TradingAPI, comment string) *AutoFeedback {
+	return &AutoFeedback{
+		api:     api,
+		Comment: comment,
+	}
+}
+
+// Run leaves feedback for the line items of the orders, e.g. from GetOrders or OrderSyncer, that are paid,
+// delivered and still awaiting feedback according to GetItemsAwaitingFeedback. GetItemsAwaitingFeedback is
+// only called when some line item is paid and delivered
+func (a *AutoFeedback) Run(ctx context.Context, orders []Order) ([]AutoFeedbackResult, error) {
+	type candidate struct {
+		order *Order
+		tr    *Transaction
+	}
+	var candidates []candidate
+	for i := range orders {
+		order := &orders[i]
+		if !order.Paid() {
+			continue
+		}
+		for j := range order.TransactionArray.Transaction {
+			if tr := &order.TransactionArray.Transaction[j]; a.delivered(order, tr) {
+				candidates = append(candidates, candidate{order, tr})
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	awaiting, err := a.awaitingFeedback(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []AutoFeedbackResult
+	for _, c := range candidates {
+		order, tr := c.order, c.tr
+		key := tr.Item.ItemID + "|" + tr.TransactionID
+		if !awaiting[key] {
+			continue
+		}
+
+		buyer := order.BuyerUserID
+		if tr.Buyer.UserID != "" {
+			buyer = tr.Buyer.UserID
+		}
+		result := AutoFeedbackResult{
+			OrderID:       order.OrderID,
+			ItemID:        tr.Item.ItemID,
+			TransactionID: tr.TransactionID,
+			Buyer:         buyer,
+		}
+
+		resp, err := a.api.LeaveFeedback(ctx, &LeaveFeedbackRequest{
+			ItemID:        tr.Item.ItemID,
+			TransactionID: tr.TransactionID,
+			TargetUser:    buyer,
+			CommentText:   a.Comment,
+			CommentType:   CommentTypePositive,
+		})
+		if err != nil {
+			result.Err = err
+			a.api.logError("FAILED to leave feedback for "+order.OrderID+": ", err)
+		} else {
+			result.FeedbackID = resp.FeedbackID
+			delete(awaiting, key)
+		}
+		results = append(results, result)
+
+		if ctx.Err() != nil {
+			return results, ctx.Err()
+		}
+	}
+
+	return results, nil
+}
+
+// awaitingFeedback returns the ItemID|TransactionID of every line item we haven't left feedback for
+func (a *AutoFeedback) awaitingFeedback(ctx context.Context) (map[string]bool, error) {
+	awaiting := map[string]bool{}
+	for page := 1; ; page++ {
+		resp, err := a.api.GetItemsAwaitingFeedback(ctx, &GetItemsAwaitingFeedbackRequest{
+			Pagination: &Pagination{EntriesPerPage: 200, PageNumber: page},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tr := range resp.ItemsAwaitingFeedback.TransactionArray.Transaction {
+			if tr.FeedbackLeft == nil {
+				awaiting[tr.Item.ItemID+"|"+tr.TransactionID] = true
+			}
+		}
+		if page >= resp.ItemsAwaitingFeedback.PaginationResult.TotalNumberOfPages {
+			return awaiting, nil
+		}
+	}
+}
+
+// delivered reports whether every package of the line item was delivered at least DeliveredFor ago
+func (a *AutoFeedback) delivered(order *Order, tr *Transaction) bool {
+	packages := tr.ShippingServiceSelected.ShippingPackageInfo
+	if len(packages) == 0 {
+		packages = order.ShippingServiceSelected.ShippingPackageInfo
+	}
+	if len(packages) == 0 {
+		return false
+	}
+
+	for _, p := range packages {
+		if p.ActualDeliveryTime == nil || time.Since(*p.ActualDeliveryTime) < a.DeliveredFor {
+			return false
+		}
+	}
+
+	return true
+}
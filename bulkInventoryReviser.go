@@ -0,0 +1,224 @@
+package ebayapi
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// InventoryStatusResult is the outcome of a single update with BulkInventoryReviser
+type InventoryStatusResult struct {
+	// Index is the position of the update in the slice passed to Revise
+	Index  int
+	Update *InventoryStatus
+	// Revised holds the quantity and price ebay reported after the update
+	Revised  *InventoryStatus
+	Attempts int
+	Err      error
+}
+
+// InventoryStatusReport holds the results of a Revise run in input order
+type InventoryStatusReport []InventoryStatusResult
+
+// Failed returns the results of updates ebay did not apply
+func (r InventoryStatusReport) Failed() []InventoryStatusResult {
+	var results []InventoryStatusResult
+	for _, res := range r {
+		if res.Err != nil {
+			results = append(results, res)
+		}
+	}
+
+	return results
+}
+
+// BySKU returns the results keyed by SKU, updates without SKU are left out
+func (r InventoryStatusReport) BySKU() map[string]InventoryStatusResult {
+	results := map[string]InventoryStatusResult{}
+	for _, res := range r {
+		if res.Update.SKU != "" {
+			results[res.Update.SKU] = res
+		}
+	}
+
+	return results
+}
+
+// BulkInventoryReviser revises any number of quantities and prices with ReviseInventoryStatus, 4 per call
+type BulkInventoryReviser struct {
+	api *TradingAPI
+
+	// Concurrency is the number of ReviseInventoryStatus calls in flight
+	Concurrency int
+	// Retries is how many times updates failing with transient errors are resubmitted
+	Retries int
+}
+
+// NewBulkInventoryReviser instantiates a BulkInventoryReviser with conservative defaults
+func NewBulkInventoryReviser(api *TradingAPI) *BulkInventoryReviser {
+	return &BulkInventoryReviser{
+		api:         api,
+		Concurrency: 2,
+		Retries:     1,
+	}
+}
+
+// Revise applies all updates and returns an outcome per update. Invalid updates fail without a call
+func (b *BulkInventoryReviser) Revise(ctx context.Context, updates []*InventoryStatus) InventoryStatusReport {
+	results := make(InventoryStatusReport, len(updates))
+	var pending []int
+	for i, update := range updates {
+		results[i] = InventoryStatusResult{Index: i, Update: update}
+		if err := update.Validate(); err != nil {
+			results[i].Err = err
+			continue
+		}
+		pending = append(pending, i)
+	}
+
+	for attempt := 0; attempt <= b.Retries && len(pending) > 0; attempt++ {
+		if attempt > 0 {
+			// Backoff before retrying failed updates
+			select {
+			case <-time.After(500 * time.Millisecond):
+			case <-ctx.Done():
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			// Updates never submitted must not look applied
+			for _, i := range pending {
+				if results[i].Err == nil {
+					results[i].Err = err
+				}
+			}
+			break
+		}
+
+		b.run(ctx, pending, results)
+
+		var retry []int
+		for _, i := range pending {
+			if retryable(results[i].Err) {
+				retry = append(retry, i)
+			}
+		}
+		pending = retry
+	}
+
+	return results
+}
+
+func (b *BulkInventoryReviser) run(ctx context.Context, pending []int, results InventoryStatusReport) {
+	concurrency := b.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var waitGroup sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for start := 0; start < len(pending); start += MaxInventoryStatusPerCall {
+		end := start + MaxInventoryStatusPerCall
+		if end > len(pending) {
+			end = len(pending)
+		}
+		batch := pending[start:end]
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			for _, i := range pending[start:] {
+				results[i].Err = ctx.Err()
+			}
+			waitGroup.Wait()
+			return
+		}
+
+		waitGroup.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				waitGroup.Done()
+			}()
+			b.reviseBatch(ctx, batch, results)
+		}()
+	}
+	waitGroup.Wait()
+}
+
+// reviseBatch sends one ReviseInventoryStatus call, each goroutine writes only the results of its own batch
+func (b *BulkInventoryReviser) reviseBatch(ctx context.Context, batch []int, results InventoryStatusReport) {
+	req := &ReviseInventoryStatusRequest{}
+	for _, i := range batch {
+		results[i].Attempts++
+		req.InventoryStatus = append(req.InventoryStatus, results[i].Update)
+	}
+
+	response, err := b.api.client.DoSOAPcall(ctx, req)
+	ebayErrs, isEbayErr := err.(EbayErrors)
+	if err != nil && !isEbayErr {
+		for _, i := range batch {
+			results[i].Err = err
+		}
+		b.api.logError("FAILED ReviseInventoryStatus call: ", err)
+		return
+	}
+	resp := response.(ReviseInventoryStatusResponse)
+	if !isEbayErr {
+		ebayErrs = resp.Errors
+	}
+
+	revised := map[string]InventoryStatus{}
+	for _, status := range resp.InventoryStatus {
+		if status.SKU != "" {
+			revised["SKU:"+status.SKU] = status
+		}
+		revised["ItemID:"+status.ItemID] = status
+	}
+
+	for _, i := range batch {
+		update := results[i].Update
+		errs := errorsFor(ebayErrs.Errors(), update)
+		status, ok := revised[update.key()]
+
+		switch {
+		case len(errs) > 0:
+			results[i].Err = errs
+		case !ok && len(ebayErrs.Errors()) > 0:
+			// The failure could not be attributed to a single update
+			results[i].Err = ebayErrs.Errors()
+		case !ok:
+			results[i].Err = nil
+		default:
+			results[i].Err = nil
+			results[i].Revised = &status
+		}
+	}
+}
+
+// errorsFor returns the errors whose parameters name the SKU or ItemID of the update
+func errorsFor(errs EbayErrors, update *InventoryStatus) EbayErrors {
+	var matched EbayErrors
+	for _, e := range errs {
+		for _, p := range e.ErrorParameters {
+			if (update.SKU != "" && p.Value == update.SKU) || (update.SKU == "" && p.Value == update.ItemID) {
+				matched = append(matched, e)
+				break
+			}
+		}
+	}
+
+	return matched
+}
+
+// retryable reports whether a failure may succeed when resubmitted. Updates set absolute quantities and
+// prices, so resubmitting one that was applied before a transport failure is harmless
+func retryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if ebayErrs, ok := err.(EbayErrors); ok {
+		return ebayErrs.SystemError()
+	}
+
+	return true
+}
@@ -0,0 +1,239 @@
+package ebayapi
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// BulkListResult is the outcome of listing a single item with BulkLister
+type BulkListResult struct {
+	// Index is the position of the item in the slice passed to List
+	Index     int
+	Item      *Item
+	MessageID string
+	ItemID    string
+	StartTime *time.Time
+	EndTime   *time.Time
+	Fees      Fees
+	Warnings  EbayErrors
+	Attempts  int
+	Err       error
+}
+
+// BulkListReport holds the results of a List run in input order
+type BulkListReport []BulkListResult
+
+// Succeeded returns the results of listed items
+func (r BulkListReport) Succeeded() []BulkListResult {
+	var results []BulkListResult
+	for _, res := range r {
+		if res.Err == nil {
+			results = append(results, res)
+		}
+	}
+
+	return results
+}
+
+// Failed returns the results of items that could not be listed
+func (r BulkListReport) Failed() []BulkListResult {
+	var results []BulkListResult
+	for _, res := range r {
+		if res.Err != nil {
+			results = append(results, res)
+		}
+	}
+
+	return results
+}
+
+// BulkLister lists any number of items with AddItems, 5 per call. With the TradingAPI in DryRun mode every
+// item is verified on its own with VerifyAddItem or VerifyAddFixedPriceItem instead, as there is no VerifyAddItems
+type BulkLister struct {
+	api *TradingAPI
+
+	// Concurrency is the number of AddItems calls in flight
+	Concurrency int
+	// Retries is how many times items failing with ebay system errors are resubmitted
+	Retries int
+	// Validator optionally checks items against their category rules, invalid items are not submitted
+	Validator *ListingValidator
+}
+
+// NewBulkLister instantiates a BulkLister with conservative defaults
+func NewBulkLister(api *TradingAPI) *BulkLister {
+	return &BulkLister{
+		api:         api,
+		Concurrency: 2,
+		Retries:     1,
+	}
+}
+
+// List lists all items and returns an outcome per item. Only items failing with an ebay system error are
+// retried, requests ebay rejects and transport failures are reported straight away
+func (b *BulkLister) List(ctx context.Context, items []*Item) BulkListReport {
+	results := make(BulkListReport, len(items))
+	var pending []int
+	for i, item := range items {
+		results[i] = BulkListResult{Index: i, Item: item, MessageID: strconv.Itoa(i)}
+		if b.Validator != nil {
+			issues, err := b.Validator.Validate(ctx, item)
+			if err == nil {
+				err = issues.Err()
+			}
+			if err != nil {
+				results[i].Err = err
+				continue
+			}
+		}
+		pending = append(pending, i)
+	}
+
+	for attempt := 0; attempt <= b.Retries && len(pending) > 0; attempt++ {
+		if attempt > 0 {
+			// Backoff before retrying failed items
+			select {
+			case <-time.After(500 * time.Millisecond):
+			case <-ctx.Done():
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			// Items never submitted must not look listed
+			for _, i := range pending {
+				if results[i].Err == nil {
+					results[i].Err = err
+				}
+			}
+			break
+		}
+
+		b.run(ctx, pending, results)
+
+		var retry []int
+		for _, i := range pending {
+			// Only ebay system errors are retried, after a transport failure the items may have been listed
+			// already and resubmitting them would create duplicate listings
+			if ebayErrs, ok := results[i].Err.(EbayErrors); ok && ebayErrs.SystemError() {
+				retry = append(retry, i)
+			}
+		}
+		pending = retry
+	}
+
+	return results
+}
+
+func (b *BulkLister) run(ctx context.Context, pending []int, results BulkListReport) {
+	concurrency := b.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var waitGroup sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for start := 0; start < len(pending); start += MaxAddItemsPerCall {
+		end := start + MaxAddItemsPerCall
+		if end > len(pending) {
+			end = len(pending)
+		}
+		batch := pending[start:end]
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			for _, i := range pending[start:] {
+				results[i].Err = ctx.Err()
+			}
+			waitGroup.Wait()
+			return
+		}
+
+		waitGroup.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				waitGroup.Done()
+			}()
+			if b.api.DryRun {
+				b.verifyBatch(ctx, batch, results)
+			} else {
+				b.listBatch(ctx, batch, results)
+			}
+		}()
+	}
+	waitGroup.Wait()
+}
+
+// verifyBatch verifies the items of a batch one by one, filling in fees without listing anything
+func (b *BulkLister) verifyBatch(ctx context.Context, batch []int, results BulkListReport) {
+	for _, i := range batch {
+		results[i].Attempts++
+		item := results[i].Item
+
+		if item.ListingType == "FixedPriceItem" || item.ListingType == "StoresFixedPrice" {
+			resp, err := b.api.VerifyAddFixedPriceItem(ctx, item)
+			results[i].Err = err
+			if err == nil {
+				results[i].Fees = resp.Fees
+				results[i].Warnings = resp.Warnings()
+			}
+			continue
+		}
+
+		resp, err := b.api.VerifyAddItem(ctx, item)
+		results[i].Err = err
+		if err == nil {
+			results[i].Fees = resp.Fees
+			results[i].Warnings = resp.Warnings()
+		}
+	}
+}
+
+// listBatch sends one AddItems call, each goroutine writes only the results of its own batch
+func (b *BulkLister) listBatch(ctx context.Context, batch []int, results BulkListReport) {
+	req := &AddItemsRequest{}
+	for _, i := range batch {
+		results[i].Attempts++
+		req.AddItemRequestContainer = append(req.AddItemRequestContainer, &AddItemRequestContainer{
+			Item:      results[i].Item,
+			MessageID: results[i].MessageID,
+		})
+	}
+
+	response, err := b.api.client.DoSOAPcall(ctx, req)
+	if _, ok := err.(EbayErrors); err != nil && !ok {
+		for _, i := range batch {
+			results[i].Err = err
+		}
+		b.api.logError("FAILED AddItems call: ", err)
+		return
+	}
+	resp := response.(AddItemsResponse)
+
+	containers := map[string]AddItemResponseContainer{}
+	for _, c := range resp.AddItemResponseContainer {
+		containers[c.CorrelationID] = c
+	}
+
+	for _, i := range batch {
+		c, ok := containers[results[i].MessageID]
+		switch {
+		case !ok && err != nil:
+			results[i].Err = err
+		case !ok:
+			results[i].Err = errors.New("ERROR[AddItems]: no result for MessageID " + results[i].MessageID)
+		case c.Failure():
+			results[i].Err = EbayErrors(c.Errors)
+		default:
+			results[i].Err = nil
+			results[i].ItemID = c.ItemID
+			results[i].StartTime = c.StartTime
+			results[i].EndTime = c.EndTime
+			results[i].Fees = c.Fees
+			results[i].Warnings = EbayErrors(c.Errors)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package ebayapi
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// CategoryTree is a local copy of a site's category hierarchy, versioned by ebay's CategoryVersion
+type CategoryTree struct {
+	SiteID     string
+	Version    string
+	UpdateTime time.Time
+	Categories []Category
+
+	byID     map[string]*Category
+	children map[string][]*Category
+}
+
+// NewCategoryTree indexes the categories returned by GetCategories
+func NewCategoryTree(siteID, version string, updated time.Time, categories []Category) *CategoryTree {
+	t := &CategoryTree{
+		SiteID:     siteID,
+		Version:    version,
+		UpdateTime: updated,
+		Categories: categories,
+	}
+	t.index()
+	return t
+}
+
+func (t *CategoryTree) index() {
+	t.byID = make(map[string]*Category, len(t.Categories))
+	t.children = map[string][]*Category{}
+	for i := range t.Categories {
+		c := &t.Categories[i]
+		t.byID[c.CategoryID] = c
+		if parent := c.parentID(); parent != "" {
+			t.children[parent] = append(t.children[parent], c)
+		}
+	}
+}
+
+// parentID returns the parent category, top level categories are their own parent in GetCategories
+func (c Category) parentID() string {
+	if len(c.CategoryParentID) == 0 || c.CategoryParentID[0] == c.CategoryID {
+		return ""
+	}
+	return c.CategoryParentID[0]
+}
+
+// ReadCategoryTree loads a tree saved with Save
+func ReadCategoryTree(path string) (*CategoryTree, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var t CategoryTree
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, err
+	}
+	t.index()
+	return &t, nil
+}
+
+// Save writes the tree as JSON, replacing the file atomically
+func (t *CategoryTree) Save(path string) error {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Get returns the category with the given ID
+func (t *CategoryTree) Get(categoryID string) (*Category, bool) {
+	c, ok := t.byID[categoryID]
+	return c, ok
+}
+
+// Children returns the direct subcategories, or the top level categories for an empty ID
+func (t *CategoryTree) Children(categoryID string) []*Category {
+	if categoryID != "" {
+		return t.children[categoryID]
+	}
+
+	var roots []*Category
+	for i := range t.Categories {
+		if t.Categories[i].parentID() == "" {
+			roots = append(roots, &t.Categories[i])
+		}
+	}
+	return roots
+}
+
+// Path returns the categories from the top level down to the given category
+func (t *CategoryTree) Path(categoryID string) []*Category {
+	var path []*Category
+	for c, ok := t.byID[categoryID]; ok; c, ok = t.byID[c.parentID()] {
+		path = append([]*Category{c}, path...)
+		if c.parentID() == "" || len(path) > len(t.Categories) {
+			break
+		}
+	}
+
+	return path
+}
+
+// PathName returns the category path as "Parent > Child > Leaf"
+func (t *CategoryTree) PathName(categoryID string) string {
+	var names []string
+	for _, c := range t.Path(categoryID) {
+		names = append(names, c.CategoryName)
+	}
+	return strings.Join(names, " > ")
+}
+
+// Search returns the leaf categories whose path contains every word of the query, case insensitive
+func (t *CategoryTree) Search(query string) []*Category {
+	words := strings.Fields(strings.ToLower(query))
+
+	var found []*Category
+	for i := range t.Categories {
+		c := &t.Categories[i]
+		if !c.LeafCategory || c.Expired {
+			continue
+		}
+
+		path := strings.ToLower(t.PathName(c.CategoryID))
+		matched := true
+		for _, w := range words {
+			if !strings.Contains(path, w) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			found = append(found, c)
+		}
+	}
+
+	sort.Slice(found, func(i, j int) bool {
+		return t.PathName(found[i].CategoryID) < t.PathName(found[j].CategoryID)
+	})
+	return found
+}
+
+// RefreshCategoryTree returns the current category tree of the site. The full hierarchy is only downloaded
+// when tree is nil or ebay reports a CategoryVersion different from tree.Version
+func (api *TradingAPI) RefreshCategoryTree(ctx context.Context, siteID string, tree *CategoryTree) (*CategoryTree, bool, error) {
+	if tree != nil && tree.SiteID == siteID {
+		version, err := api.GetCategories(ctx, &GetCategoriesRequest{CategorySiteID: siteID})
+		if err != nil {
+			return nil, false, err
+		}
+		if version.CategoryVersion == tree.Version {
+			return tree, false, nil
+		}
+	}
+
+	full, err := api.GetCategories(ctx, &GetCategoriesRequest{
+		CategorySiteID: siteID,
+		ViewAllNodes:   true,
+		DetailLevel:    []string{DetailLevelReturnAll},
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return NewCategoryTree(siteID, full.CategoryVersion, full.UpdateTime, full.CategoryArray.Category), true, nil
+}
+
+// SyncCategoryTree loads the tree cached at path, refreshes it when the category version changed and
+// saves it back
+func (api *TradingAPI) SyncCategoryTree(ctx context.Context, siteID, path string) (*CategoryTree, error) {
+	cached, err := ReadCategoryTree(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	tree, changed, err := api.RefreshCategoryTree(ctx, siteID, cached)
+	if err != nil {
+		return nil, err
+	}
+	if changed {
+		if err := tree.Save(path); err != nil {
+			return nil, err
+		}
+	}
+
+	return tree, nil
+}
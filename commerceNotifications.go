@@ -0,0 +1,373 @@
+package ebayapi
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"hash"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+//
+// The Commerce Notification API delivers JSON webhooks signed with the X-EBAY-SIGNATURE header. Endpoints
+// are validated by ebay with a GET challenge_code request that must be answered with a SHA-256 hash.
+//
+
+// DefaultPublicKeyTTL is how long notification public keys are cached, as recommended by ebay
+const DefaultPublicKeyTTL = time.Hour
+
+// TopicAuthorizationRevocation is the Notification API topic sent when a user revokes the application's access
+const TopicAuthorizationRevocation = "AUTHORIZATION_REVOCATION"
+
+// AuthorizationRevocationData is the payload of an AUTHORIZATION_REVOCATION notification
+type AuthorizationRevocationData struct {
+	Username       string    `json:"username"`
+	UserID         string    `json:"userId"`
+	EIASToken      string    `json:"eiasToken"`
+	RevokeReason   string    `json:"revokeReason"`
+	RevocationDate time.Time `json:"revocationDate"`
+}
+
+// CommerceNotification is the JSON webhook envelope shared by all Notification API topics
+type CommerceNotification struct {
+	Metadata struct {
+		Topic         string `json:"topic"`
+		SchemaVersion string `json:"schemaVersion"`
+		Deprecated    bool   `json:"deprecated"`
+	} `json:"metadata"`
+	Notification struct {
+		NotificationID      string          `json:"notificationId"`
+		EventDate           time.Time       `json:"eventDate"`
+		PublishDate         time.Time       `json:"publishDate"`
+		PublishAttemptCount int             `json:"publishAttemptCount"`
+		Data                json.RawMessage `json:"data"`
+	} `json:"notification"`
+
+	// Payload holds Data decoded into the typed struct of the topic, nil for unknown topics
+	Payload interface{} `json:"-"`
+}
+
+// DecodeData unmarshals the topic specific notification data into v
+func (n *CommerceNotification) DecodeData(v interface{}) error {
+	return json.Unmarshal(n.Notification.Data, v)
+}
+
+// commerceTopicPayloads maps known topics to constructors of their typed payloads, other topics can be added
+// per handler with RegisterPayload
+var commerceTopicPayloads = map[string]func() interface{}{
+	TopicAuthorizationRevocation:    func() interface{} { return &AuthorizationRevocationData{} },
+	TopicMarketplaceAccountDeletion: func() interface{} { return &AccountDeletionData{} },
+}
+
+// CommerceNotificationFunc is called for every verified notification of the topic it is registered for
+type CommerceNotificationFunc func(ctx context.Context, n *CommerceNotification) error
+
+// NotificationKeyFetcher resolves the public key referenced by an X-EBAY-SIGNATURE header
+type NotificationKeyFetcher interface {
+	PublicKey(ctx context.Context, keyID string) (*ecdsa.PublicKey, error)
+}
+
+type notificationSignature struct {
+	Alg       string `json:"alg"`
+	Kid       string `json:"kid"`
+	Signature string `json:"signature"`
+	Digest    string `json:"digest"`
+}
+
+type notificationPublicKey struct {
+	Algorithm string `json:"algorithm"`
+	Digest    string `json:"digest"`
+	Key       string `json:"key"`
+}
+
+type cachedPublicKey struct {
+	key     *ecdsa.PublicKey
+	expires time.Time
+}
+
+// CachedKeyFetcher fetches notification public keys with the client's application token and caches them
+type CachedKeyFetcher struct {
+	client *EbayClient
+
+	// TTL is how long a fetched key is reused
+	TTL time.Duration
+
+	mu   sync.Mutex
+	keys map[string]cachedPublicKey
+}
+
+// NewCachedKeyFetcher instantiates a key fetcher backed by the Notification API getPublicKey call
+func NewCachedKeyFetcher(cli *EbayClient) *CachedKeyFetcher {
+	return &CachedKeyFetcher{
+		client: cli,
+		TTL:    DefaultPublicKeyTTL,
+		keys:   map[string]cachedPublicKey{},
+	}
+}
+
+// PublicKey returns the cached key or fetches it from ebay
+func (f *CachedKeyFetcher) PublicKey(ctx context.Context, keyID string) (*ecdsa.PublicKey, error) {
+	f.mu.Lock()
+	cached, ok := f.keys[keyID]
+	f.mu.Unlock()
+	if ok && time.Now().Before(cached.expires) {
+		return cached.key, nil
+	}
+
+	var resp notificationPublicKey
+	err := f.client.doOAuthJSONcall(ctx, "GET", "/commerce/notification/v1/public_key/"+url.PathEscape(keyID), nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := parseNotificationPublicKey(resp.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	f.keys[keyID] = cachedPublicKey{key: key, expires: time.Now().Add(f.TTL)}
+	f.mu.Unlock()
+	return key, nil
+}
+
+// parseNotificationPublicKey decodes the PEM formatted key, which ebay may send without line breaks
+func parseNotificationPublicKey(pem string) (*ecdsa.PublicKey, error) {
+	pem = strings.Replace(pem, "-----BEGIN PUBLIC KEY-----", "", 1)
+	pem = strings.Replace(pem, "-----END PUBLIC KEY-----", "", 1)
+	pem = strings.Join(strings.Fields(pem), "")
+
+	der, err := base64.StdEncoding.DecodeString(pem)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, err
+	}
+
+	key, ok := pub.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, errors.New("ERROR[PublicKey]: notification public key is not ECDSA")
+	}
+	return key, nil
+}
+
+// CommerceNotificationHandler is an http.Handler for Notification API webhooks
+type CommerceNotificationHandler struct {
+	keys   NotificationKeyFetcher
+	logger *logrus.Logger
+
+	// Endpoint is the delivery URL exactly as configured with ebay, used for the challenge response
+	Endpoint string
+	// VerificationToken is the token configured with ebay for the endpoint
+	VerificationToken string
+
+	mu       sync.RWMutex
+	handlers map[string]CommerceNotificationFunc
+	payloads map[string]func() interface{}
+	fallback CommerceNotificationFunc
+}
+
+// NewCommerceNotificationHandler instantiates a webhook handler for the configured endpoint
+func NewCommerceNotificationHandler(keys NotificationKeyFetcher, endpoint, verificationToken string, l *logrus.Logger) *CommerceNotificationHandler {
+	return &CommerceNotificationHandler{
+		keys:              keys,
+		logger:            l,
+		Endpoint:          endpoint,
+		VerificationToken: verificationToken,
+		handlers:          map[string]CommerceNotificationFunc{},
+		payloads:          map[string]func() interface{}{},
+	}
+}
+
+// Handle registers the callback for a notification topic
+func (h *CommerceNotificationHandler) Handle(topic string, fn CommerceNotificationFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.handlers[topic] = fn
+}
+
+// RegisterPayload sets the constructor of the typed payload Data is decoded into for a topic, making it
+// available as n.Payload in the callbacks
+func (h *CommerceNotificationHandler) RegisterPayload(topic string, newPayload func() interface{}) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.payloads[topic] = newPayload
+}
+
+// HandleDefault registers the callback for topics without a dedicated handler
+func (h *CommerceNotificationHandler) HandleDefault(fn CommerceNotificationFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.fallback = fn
+}
+
+// ChallengeResponse returns hex(SHA-256(challengeCode + verificationToken + endpoint))
+func (h *CommerceNotificationHandler) ChallengeResponse(challengeCode string) string {
+	sum := sha256.Sum256([]byte(challengeCode + h.VerificationToken + h.Endpoint))
+	return hex.EncodeToString(sum[:])
+}
+
+// Verify checks the X-EBAY-SIGNATURE header value against the raw request body
+func (h *CommerceNotificationHandler) Verify(ctx context.Context, header string, body []byte) error {
+	data, err := base64.StdEncoding.DecodeString(header)
+	if err != nil {
+		return ErrInvalidNotificationSignature
+	}
+	var sig notificationSignature
+	if err := json.Unmarshal(data, &sig); err != nil || sig.Kid == "" {
+		return ErrInvalidNotificationSignature
+	}
+	if !strings.EqualFold(sig.Alg, "ECDSA") {
+		return ErrInvalidNotificationSignature
+	}
+
+	var digest hash.Hash
+	switch strings.ToUpper(sig.Digest) {
+	case "SHA1", "":
+		digest = sha1.New()
+	case "SHA256":
+		digest = sha256.New()
+	default:
+		return ErrInvalidNotificationSignature
+	}
+	digest.Write(body)
+
+	signature, err := base64.StdEncoding.DecodeString(sig.Signature)
+	if err != nil {
+		return ErrInvalidNotificationSignature
+	}
+
+	key, err := h.keys.PublicKey(ctx, sig.Kid)
+	if err != nil {
+		return err
+	}
+	if !ecdsa.VerifyASN1(key, digest.Sum(nil), signature) {
+		return ErrInvalidNotificationSignature
+	}
+
+	return nil
+}
+
+// ParseNotification decodes the webhook body and its typed payload
+func (h *CommerceNotificationHandler) ParseNotification(body []byte) (*CommerceNotification, error) {
+	var n CommerceNotification
+	if err := json.Unmarshal(body, &n); err != nil {
+		return nil, err
+	}
+	if n.Metadata.Topic == "" {
+		return nil, errors.New("ERROR[CommerceNotification]: topic missing")
+	}
+
+	h.mu.RLock()
+	newPayload, ok := h.payloads[n.Metadata.Topic]
+	h.mu.RUnlock()
+	if !ok {
+		newPayload, ok = commerceTopicPayloads[n.Metadata.Topic]
+	}
+	if ok {
+		payload := newPayload()
+		if err := n.DecodeData(payload); err != nil {
+			return nil, err
+		}
+		n.Payload = payload
+	}
+
+	return &n, nil
+}
+
+// ServeHTTP answers challenge requests and verifies, decodes and dispatches notifications
+func (h *CommerceNotificationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.serveChallenge(w, r)
+	case http.MethodPost:
+		h.serveNotification(w, r)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (h *CommerceNotificationHandler) serveChallenge(w http.ResponseWriter, r *http.Request) {
+	code := r.URL.Query().Get("challenge_code")
+	if code == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(struct {
+		ChallengeResponse string `json:"challengeResponse"`
+	}{h.ChallengeResponse(code)})
+}
+
+func (h *CommerceNotificationHandler) serveNotification(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, MaxNotificationSize))
+	if err != nil {
+		h.logError("FAILED to read commerce notification: ", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Verify(r.Context(), r.Header.Get("X-EBAY-SIGNATURE"), body); err == ErrInvalidNotificationSignature {
+		h.logError("REJECTED commerce notification: ", err)
+		w.WriteHeader(http.StatusPreconditionFailed)
+		return
+	} else if err != nil {
+		// Key lookup failures are ours, let ebay retry the delivery
+		h.logError("FAILED to verify commerce notification: ", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	n, err := h.ParseNotification(body)
+	if err != nil {
+		h.logError("FAILED to parse commerce notification: ", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	h.mu.RLock()
+	fn, ok := h.handlers[n.Metadata.Topic]
+	if !ok {
+		fn = h.fallback
+	}
+	h.mu.RUnlock()
+
+	if fn == nil {
+		if h.logger != nil {
+			h.logger.Debug("UNHANDLED commerce notification: ", n.Metadata.Topic)
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if err := fn(r.Context(), n); err != nil {
+		h.logError("FAILED to handle commerce notification "+n.Metadata.Topic+": ", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *CommerceNotificationHandler) logError(msg string, err error) {
+	if h.logger != nil {
+		h.logger.Error(msg, err.Error())
+	}
+}
@@ -2,30 +2,39 @@ package ebayapi
 
 import (
 	"encoding/xml"
+	"errors"
 	"time"
 )
 
-// CompleteSaleRequest type
+// CompleteSaleRequest type - Paid and Shipped are pointers so false can be sent, use Bool(false) to unmark
 type CompleteSaleRequest struct {
 	XMLName              xml.Name
 	RequesterCredentials *RequesterCredentials
-	ItemID               string    `xml:"ItemID,omitempty"`
-	OrderID              string    `xml:"OrderID,omitempty"`
-	OrderLineItemID      string    `xml:"OrderLineItemID,omitempty"`
-	Paid                 bool      `xml:"Paid,omitempty"`
-	Shipment             *Shipment `xml:"Shipment,omitempty"`
-	Shipped              bool      `xml:"Shipped,omitempty"`
-	TransactionID        string    `xml:"TransactionID,omitempty"`
-	ErrorHandling        string    `xml:"ErrorHandling,omitempty"`
-	ErrorLanguage        string    `xml:"ErrorLanguage,omitempty"`
-	MessageID            string    `xml:"MessageID,omitempty"`
-	Version              string    `xml:"Version,omitempty"`
-	WarningLevel         string    `xml:"WarningLevel,omitempty"`
-	// FeedbackInfo struct {
-	// 	CommentText string `xml:"CommentText,omitempty"`
-	// 	CommentType string `xml:"CommentType,omitempty"`
-	// 	TargetUser  string `xml:"TargetUser,omitempty"`
-	// } `xml:"FeedbackInfo,omitempty"`
+	ItemID               string        `xml:"ItemID,omitempty"`
+	OrderID              string        `xml:"OrderID,omitempty"`
+	OrderLineItemID      string        `xml:"OrderLineItemID,omitempty"`
+	Paid                 *bool         `xml:"Paid,omitempty"`
+	Shipment             *Shipment     `xml:"Shipment,omitempty"`
+	Shipped              *bool         `xml:"Shipped,omitempty"`
+	TransactionID        string        `xml:"TransactionID,omitempty"`
+	ErrorHandling        string        `xml:"ErrorHandling,omitempty"`
+	ErrorLanguage        string        `xml:"ErrorLanguage,omitempty"`
+	MessageID            string        `xml:"MessageID,omitempty"`
+	Version              string        `xml:"Version,omitempty"`
+	WarningLevel         string        `xml:"WarningLevel,omitempty"`
+	FeedbackInfo         *FeedbackInfo `xml:"FeedbackInfo,omitempty"`
+}
+
+// Validate checks the order or line item is identified and the feedback can be left
+func (c CompleteSaleRequest) Validate() error {
+	if c.OrderID == "" && c.OrderLineItemID == "" && (c.ItemID == "" || c.TransactionID == "") {
+		return errors.New("ERROR[CompleteSale]: OrderID, OrderLineItemID or ItemID and TransactionID value missing")
+	}
+	if c.FeedbackInfo != nil {
+		return c.FeedbackInfo.Validate()
+	}
+
+	return nil
 }
 
 // Shipment type
@@ -5,10 +5,16 @@ import (
 	"context"
 	"encoding/xml"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"mime/multipart"
 	"net/http"
+	"net/textproto"
 	"net/url"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/sirupsen/logrus"
 )
@@ -27,6 +33,11 @@ type EbayClient struct {
 	baseURL string
 	SiteID  int
 	logger  *logrus.Logger
+
+	// OAuth application token cache, see ApplicationToken
+	tokenMu        sync.Mutex
+	appToken       string
+	appTokenExpiry time.Time
 }
 
 // NewSandboxClient initializes EbayClient for the 'sandbox' dev environment/markets
@@ -51,14 +62,25 @@ func (e *EbayClient) parseSOAPrequest(c Call) (*http.Request, error) {
 		command: c,
 	}
 
-	body := new(bytes.Buffer)
-	body.Write([]byte(xml.Header))
-	err := xml.NewEncoder(body).Encode(ec)
+	payload := new(bytes.Buffer)
+	payload.Write([]byte(xml.Header))
+	err := xml.NewEncoder(payload).Encode(ec)
 
 	if err != nil {
 		return nil, err
 	}
 
+	var body io.Reader = payload
+	contentType := "text/xml"
+	if mc, ok := c.(MultipartCall); ok {
+		if filename, data := mc.Attachment(); data != nil {
+			body, contentType, err = multipartBody(payload.Bytes(), filename, data)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	req, _ := http.NewRequest(
 		"POST",
 		fmt.Sprintf("%s/ws/api.dll", e.baseURL),
@@ -71,10 +93,10 @@ func (e *EbayClient) parseSOAPrequest(c Call) (*http.Request, error) {
 	req.Header.Add("X-EBAY-API-CALL-NAME", c.CallName())
 	req.Header.Add("X-EBAY-API-SITEID", strconv.Itoa(e.SiteID))
 	req.Header.Add("X-EBAY-API-COMPATIBILITY-LEVEL", strconv.Itoa(1113))
-	req.Header.Add("Content-Type", "text/xml")
+	req.Header.Add("Content-Type", contentType)
 
 	if e.logger != nil {
-		xmlString, err := PrettPrintXML(body.Bytes())
+		xmlString, err := PrettPrintXML(payload.Bytes())
 		if err == nil {
 			fmt.Println()
 			e.logger.Debug("REQUESTING: ", c.CallName())
@@ -87,6 +109,44 @@ func (e *EbayClient) parseSOAPrequest(c Call) (*http.Request, error) {
 	return req, nil
 }
 
+// quoteEscaper escapes a quoted header parameter like mime/multipart does, dropping line breaks which would
+// end the header
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"", "\r", "", "\n", "")
+
+// multipartBody builds a multipart/form-data body holding the XML payload followed by the binary attachment
+func multipartBody(payload []byte, filename string, data io.Reader) (io.Reader, string, error) {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+
+	xmlPart, err := writer.CreatePart(textproto.MIMEHeader{
+		"Content-Disposition": {`form-data; name="XML Payload"`},
+		"Content-Type":        {"text/xml;charset=utf-8"},
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err := xmlPart.Write(payload); err != nil {
+		return nil, "", err
+	}
+
+	binaryPart, err := writer.CreatePart(textproto.MIMEHeader{
+		"Content-Disposition":       {fmt.Sprintf(`form-data; name="dummy"; filename="%s"`, quoteEscaper.Replace(filename))},
+		"Content-Type":              {"application/octet-stream"},
+		"Content-Transfer-Encoding": {"binary"},
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err := io.Copy(binaryPart, data); err != nil {
+		return nil, "", err
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, "", err
+	}
+	return body, writer.FormDataContentType(), nil
+}
+
 // DoSOAPcall makes the requested XML request to the ebay API
 // EbayResponse must then be typecast into the correct response type
 func (e *EbayClient) DoSOAPcall(ctx context.Context, c Call) (EbayResponse, error) {
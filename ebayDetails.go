@@ -0,0 +1,341 @@
+package ebayapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// EbayDetailsCache is a local copy of a site's GeteBayDetails data. Each detail is versioned by its
+// DetailVersion and only downloaded again when ebay reports a new version
+type EbayDetailsCache struct {
+	SiteID    int
+	Versions  map[string]string
+	CheckedAt time.Time
+	Details   EbayDetails
+}
+
+// ReadEbayDetailsCache loads a cache saved with Save
+func ReadEbayDetailsCache(path string) (*EbayDetailsCache, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var c EbayDetailsCache
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// Save writes the cache as JSON, replacing the file atomically
+func (c *EbayDetailsCache) Save(path string) error {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Has reports whether all details are cached
+func (c *EbayDetailsCache) Has(names ...string) bool {
+	for _, name := range names {
+		if _, ok := c.Versions[name]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// EbayDetailsCachePath returns the cache file of a site in dir
+func EbayDetailsCachePath(dir string, siteID int) string {
+	return filepath.Join(dir, fmt.Sprintf("ebaydetails-%d.json", siteID))
+}
+
+// RefreshEbayDetails returns the cache with every named detail at ebay's current version. Only the DetailVersions
+// are requested first, details are downloaded when missing or outdated
+func (api *TradingAPI) RefreshEbayDetails(ctx context.Context, cache *EbayDetailsCache, names ...string) (*EbayDetailsCache, bool, error) {
+	if len(names) == 0 {
+		names = DefaultDetailNames
+	}
+	if cache == nil || cache.SiteID != api.client.SiteID {
+		cache = &EbayDetailsCache{SiteID: api.client.SiteID}
+	}
+	if cache.Versions == nil {
+		cache.Versions = map[string]string{}
+	}
+
+	var selectors []string
+	for _, name := range names {
+		selectors = append(selectors, name+".DetailVersion")
+	}
+	current, err := api.GeteBayDetails(ctx, &GeteBayDetailsRequest{DetailName: names, OutputSelector: selectors})
+	if err != nil {
+		return nil, false, err
+	}
+
+	var outdated []string
+	for _, name := range names {
+		version, cached := cache.Versions[name]
+		if !cached || version == "" || version != current.EbayDetails.version(name) {
+			outdated = append(outdated, name)
+		}
+	}
+
+	cache.CheckedAt = time.Now()
+	if len(outdated) == 0 {
+		return cache, false, nil
+	}
+
+	fetched, err := api.GeteBayDetails(ctx, &GeteBayDetailsRequest{DetailName: outdated})
+	if err != nil {
+		return nil, false, err
+	}
+	for _, name := range outdated {
+		cache.Details.set(name, &fetched.EbayDetails)
+		cache.Versions[name] = fetched.EbayDetails.version(name)
+	}
+
+	return cache, true, nil
+}
+
+// SyncEbayDetails loads the cache of the client's site from dir, refreshes it when it was last checked more
+// than maxAge ago or lacks a detail, and saves it back
+func (api *TradingAPI) SyncEbayDetails(ctx context.Context, dir string, maxAge time.Duration, names ...string) (*EbayDetailsCache, error) {
+	if len(names) == 0 {
+		names = DefaultDetailNames
+	}
+
+	path := EbayDetailsCachePath(dir, api.client.SiteID)
+	cache, err := ReadEbayDetailsCache(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if cache != nil && cache.SiteID == api.client.SiteID && cache.Has(names...) && time.Since(cache.CheckedAt) < maxAge {
+		return cache, nil
+	}
+
+	cache, _, err = api.RefreshEbayDetails(ctx, cache, names...)
+	if err != nil {
+		return nil, err
+	}
+	if err := cache.Save(path); err != nil {
+		return nil, err
+	}
+
+	return cache, nil
+}
+
+// version returns the DetailVersion of the named detail, empty when it wasn't returned
+func (d *EbayDetails) version(name string) string {
+	field := reflect.ValueOf(d).Elem().FieldByName(name)
+	switch {
+	case !field.IsValid():
+		return ""
+	case field.Kind() == reflect.Slice && field.Len() > 0:
+		field = field.Index(0)
+	case field.Kind() == reflect.Ptr && !field.IsNil():
+		field = field.Elem()
+	default:
+		return ""
+	}
+
+	return field.FieldByName("DetailVersion").String()
+}
+
+// set copies the named detail from other
+func (d *EbayDetails) set(name string, other *EbayDetails) {
+	field := reflect.ValueOf(d).Elem().FieldByName(name)
+	if field.IsValid() {
+		field.Set(reflect.ValueOf(other).Elem().FieldByName(name))
+	}
+}
+
+// ShippingService returns the details of a ShippingService code
+func (d *EbayDetails) ShippingService(code string) (*ShippingServiceDetails, bool) {
+	for i := range d.ShippingServiceDetails {
+		if d.ShippingServiceDetails[i].ShippingService == code {
+			return &d.ShippingServiceDetails[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// ShippingCarrier returns the details of a carrier, matched case insensitive as ebay does for ShippingCarrierUsed
+func (d *EbayDetails) ShippingCarrier(carrier string) (*ShippingCarrierDetails, bool) {
+	for i := range d.ShippingCarrierDetails {
+		if strings.EqualFold(d.ShippingCarrierDetails[i].ShippingCarrier, carrier) {
+			return &d.ShippingCarrierDetails[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// ValidCarrier reports whether carrier is a known ShippingCarrierUsed value, every carrier is valid when
+// ShippingCarrierDetails aren't loaded
+func (d *EbayDetails) ValidCarrier(carrier string) bool {
+	if len(d.ShippingCarrierDetails) == 0 {
+		return true
+	}
+	_, ok := d.ShippingCarrier(carrier)
+	return ok
+}
+
+// ValidCountry reports whether code is a known country code, every code is valid when CountryDetails aren't loaded
+func (d *EbayDetails) ValidCountry(code string) bool {
+	if len(d.CountryDetails) == 0 {
+		return true
+	}
+	for _, c := range d.CountryDetails {
+		if c.Country == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidCurrency reports whether code is a known currency code, every code is valid when CurrencyDetails aren't loaded
+func (d *EbayDetails) ValidCurrency(code string) bool {
+	if len(d.CurrencyDetails) == 0 {
+		return true
+	}
+	for _, c := range d.CurrencyDetails {
+		if c.Currency == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateItem checks the codes an item uses (country, currency, shipping services and locations, handling
+// time and return policy options) against the loaded details. Details which aren't loaded are not checked
+func (d *EbayDetails) ValidateItem(item *Item) ListingIssues {
+	var issues ListingIssues
+	add := func(field, format string, args ...interface{}) {
+		issues = append(issues, ListingIssue{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if item.Country != "" && !d.ValidCountry(item.Country) {
+		add("Country", "unknown country %s", item.Country)
+	}
+	if item.Currency != "" && !d.ValidCurrency(item.Currency) {
+		add("Currency", "unknown currency %s", item.Currency)
+	}
+
+	if item.DispatchTimeMax != 0 && len(d.DispatchTimeMaxDetails) > 0 {
+		valid := false
+		for _, dt := range d.DispatchTimeMaxDetails {
+			if dt.DispatchTimeMax == item.DispatchTimeMax {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			add("DispatchTimeMax", "%d days handling time is not offered on this site", item.DispatchTimeMax)
+		}
+	}
+
+	if len(d.ShippingLocationDetails) > 0 {
+		for _, loc := range item.ShipToLocations {
+			if !d.validShippingLocation(loc) {
+				add("ShipToLocations", "unknown location %s", loc)
+			}
+		}
+	}
+
+	if sd := item.ShippingDetails; sd != nil {
+		for _, opt := range sd.ShippingServiceOptions {
+			d.validateShippingService(opt.ShippingService, false, "ShippingServiceOptions", add)
+		}
+		for _, opt := range sd.InternationalShippingServiceOption {
+			d.validateShippingService(opt.ShippingService, true, "InternationalShippingServiceOption", add)
+			if len(d.ShippingLocationDetails) > 0 {
+				for _, loc := range opt.ShipToLocation {
+					if !d.validShippingLocation(loc) {
+						add("InternationalShippingServiceOption", "unknown location %s", loc)
+					}
+				}
+			}
+		}
+
+		if len(d.ExcludeShippingLocationDetails) > 0 {
+			for _, loc := range sd.ExcludeShipToLocation {
+				valid := false
+				for _, ex := range d.ExcludeShippingLocationDetails {
+					if ex.Location == loc {
+						valid = true
+						break
+					}
+				}
+				if !valid {
+					add("ExcludeShipToLocation", "unknown location %s", loc)
+				}
+			}
+		}
+	}
+
+	if rp, details := item.ReturnPolicy, d.ReturnPolicyDetails; rp != nil && details != nil {
+		checkOption := func(field, value string, options []ReturnPolicyOption) {
+			if value == "" || len(options) == 0 {
+				return
+			}
+			for _, o := range options {
+				if o.Option == value {
+					return
+				}
+			}
+			add("ReturnPolicy."+field, "%s is not offered on this site", value)
+		}
+		checkOption("RefundOption", rp.RefundOption, details.Refund)
+		checkOption("ReturnsAcceptedOption", rp.ReturnsAcceptedOption, details.ReturnsAccepted)
+		checkOption("ReturnsWithinOption", rp.ReturnsWithinOption, details.ReturnsWithin)
+		checkOption("ShippingCostPaidByOption", rp.ShippingCostPaidByOption, details.ShippingCostPaidBy)
+	}
+
+	return issues
+}
+
+func (d *EbayDetails) validShippingLocation(loc string) bool {
+	for _, l := range d.ShippingLocationDetails {
+		if l.ShippingLocation == loc {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (d *EbayDetails) validateShippingService(code string, international bool, field string, add func(string, string, ...interface{})) {
+	if code == "" || len(d.ShippingServiceDetails) == 0 {
+		return
+	}
+
+	service, ok := d.ShippingService(code)
+	switch {
+	case !ok:
+		add(field, "unknown shipping service %s", code)
+	case !service.ValidForSellingFlow:
+		add(field, "shipping service %s can't be used for new listings", code)
+	case international && !service.InternationalService:
+		add(field, "%s is a domestic shipping service", code)
+	case !international && service.InternationalService:
+		add(field, "%s is an international shipping service", code)
+	}
+}
@@ -0,0 +1,70 @@
+package ebayapi
+
+import (
+	"encoding/xml"
+	"errors"
+	"time"
+)
+
+// EndFixedPriceItemRequest struct - set either ItemID or SKU
+type EndFixedPriceItemRequest struct {
+	XMLName              xml.Name
+	RequesterCredentials *RequesterCredentials
+	ItemID               string    `xml:"ItemID,omitempty"`
+	SKU                  string    `xml:"SKU,omitempty"`
+	EndingReason         EndReason `xml:"EndingReason"`
+	ErrorLanguage        string    `xml:",omitempty"`
+	MessageID            string    `xml:",omitempty"`
+	Version              string    `xml:",omitempty"`
+	WarningLevel         string    `xml:",omitempty"`
+}
+
+// Validate checks the listing is identified by ItemID or SKU
+func (rq EndFixedPriceItemRequest) Validate() error {
+	if rq.ItemID == "" && rq.SKU == "" {
+		return errors.New("ERROR[EndFixedPriceItem]: ItemID or SKU value missing")
+	}
+	if rq.EndingReason == "" {
+		return errors.New("ERROR[EndFixedPriceItem]: EndingReason value missing")
+	}
+
+	return nil
+}
+
+// CallName returns name of call
+func (rq EndFixedPriceItemRequest) CallName() string {
+	return "EndFixedPriceItem"
+}
+
+// Body ataches credential and returns XML body
+func (rq EndFixedPriceItemRequest) Body(creds *Credentials) interface{} {
+	rq.XMLName = xml.Name{
+		Space: "urn:ebay:apis:eBLBaseComponents",
+		Local: rq.CallName(),
+	}
+	rq.RequesterCredentials = &RequesterCredentials{EBayAuthToken: creds.AuthToken}
+	return rq
+}
+
+// ParseResponse retruns response data as EbayResponse object
+func (rq EndFixedPriceItemRequest) ParseResponse(resp []byte) (EbayResponse, error) {
+	var xmlResponse EndFixedPriceItemResponse
+	err := xml.Unmarshal(resp, &xmlResponse)
+
+	return xmlResponse, err
+}
+
+// ResponseErrors returns errors
+func (rs EndFixedPriceItemResponse) ResponseErrors() EbayErrors {
+	return rs.ebayResponse.Errors
+}
+
+// EndFixedPriceItemResponse struct
+type EndFixedPriceItemResponse struct {
+	ebayResponse
+	EndTime *time.Time
+	SKU     string
+
+	// AlreadyEnded is set when ebay reported the listing as ended before this call
+	AlreadyEnded bool `xml:"-"`
+}
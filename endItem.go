@@ -0,0 +1,81 @@
+package ebayapi
+
+import (
+	"encoding/xml"
+	"errors"
+	"time"
+)
+
+// EndReason is the reason a seller ends a listing early
+type EndReason string
+
+// EndReason values
+const (
+	EndReasonIncorrect         EndReason = "Incorrect"
+	EndReasonLostOrBroken      EndReason = "LostOrBroken"
+	EndReasonNotAvailable      EndReason = "NotAvailable"
+	EndReasonOtherListingError EndReason = "OtherListingError"
+	EndReasonSellToHighBidder  EndReason = "SellToHighBidder"
+	EndReasonSold              EndReason = "Sold"
+)
+
+// EndItemRequest struct
+type EndItemRequest struct {
+	XMLName              xml.Name
+	RequesterCredentials *RequesterCredentials
+	ItemID               string    `xml:"ItemID"`
+	EndingReason         EndReason `xml:"EndingReason"`
+	ErrorLanguage        string    `xml:",omitempty"`
+	MessageID            string    `xml:",omitempty"`
+	Version              string    `xml:",omitempty"`
+	WarningLevel         string    `xml:",omitempty"`
+}
+
+// Validate checks the listing and the reason are set
+func (rq EndItemRequest) Validate() error {
+	if rq.ItemID == "" {
+		return errors.New("ERROR[EndItem]: ItemID value missing")
+	}
+	if rq.EndingReason == "" {
+		return errors.New("ERROR[EndItem]: EndingReason value missing")
+	}
+
+	return nil
+}
+
+// CallName returns name of call
+func (rq EndItemRequest) CallName() string {
+	return "EndItem"
+}
+
+// Body ataches credential and returns XML body
+func (rq EndItemRequest) Body(creds *Credentials) interface{} {
+	rq.XMLName = xml.Name{
+		Space: "urn:ebay:apis:eBLBaseComponents",
+		Local: rq.CallName(),
+	}
+	rq.RequesterCredentials = &RequesterCredentials{EBayAuthToken: creds.AuthToken}
+	return rq
+}
+
+// ParseResponse retruns response data as EbayResponse object
+func (rq EndItemRequest) ParseResponse(resp []byte) (EbayResponse, error) {
+	var xmlResponse EndItemResponse
+	err := xml.Unmarshal(resp, &xmlResponse)
+
+	return xmlResponse, err
+}
+
+// ResponseErrors returns errors
+func (rs EndItemResponse) ResponseErrors() EbayErrors {
+	return rs.ebayResponse.Errors
+}
+
+// EndItemResponse struct
+type EndItemResponse struct {
+	ebayResponse
+	EndTime *time.Time
+
+	// AlreadyEnded is set when ebay reported the listing as ended before this call
+	AlreadyEnded bool `xml:"-"`
+}
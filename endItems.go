@@ -0,0 +1,76 @@
+package ebayapi
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// MaxEndItemsPerCall is the number of listings ebay accepts in a single EndItems call
+const MaxEndItemsPerCall = 10
+
+// EndItemsRequest struct - ends up to 10 listings, each correlated to its result by MessageID
+type EndItemsRequest struct {
+	XMLName                 xml.Name
+	RequesterCredentials    *RequesterCredentials
+	EndItemRequestContainer []*EndItemRequestContainer `xml:"EndItemRequestContainer"`
+	ErrorLanguage           string                     `xml:",omitempty"`
+	MessageID               string                     `xml:",omitempty"`
+	Version                 string                     `xml:",omitempty"`
+	WarningLevel            string                     `xml:",omitempty"`
+}
+
+// EndItemRequestContainer struct
+type EndItemRequestContainer struct {
+	ItemID       string    `xml:"ItemID"`
+	EndingReason EndReason `xml:"EndingReason"`
+	MessageID    string    `xml:"MessageID,omitempty"`
+}
+
+// CallName returns name of call
+func (rq EndItemsRequest) CallName() string {
+	return "EndItems"
+}
+
+// Body ataches credential and returns XML body
+func (rq EndItemsRequest) Body(creds *Credentials) interface{} {
+	rq.XMLName = xml.Name{
+		Space: "urn:ebay:apis:eBLBaseComponents",
+		Local: rq.CallName(),
+	}
+	rq.RequesterCredentials = &RequesterCredentials{EBayAuthToken: creds.AuthToken}
+	return rq
+}
+
+// ParseResponse retruns response data as EbayResponse object
+func (rq EndItemsRequest) ParseResponse(resp []byte) (EbayResponse, error) {
+	var xmlResponse EndItemsResponse
+	err := xml.Unmarshal(resp, &xmlResponse)
+
+	return xmlResponse, err
+}
+
+// ResponseErrors returns errors
+func (rs EndItemsResponse) ResponseErrors() EbayErrors {
+	return rs.ebayResponse.Errors
+}
+
+// EndItemsResponse struct
+type EndItemsResponse struct {
+	ebayResponse
+	EndItemResponseContainer []EndItemResponseContainer `xml:"EndItemResponseContainer"`
+}
+
+// EndItemResponseContainer struct - CorrelationID echoes the MessageID of the request container
+type EndItemResponseContainer struct {
+	CorrelationID string
+	EndTime       *time.Time
+	Errors        []ebayResponseError
+}
+
+// EndItemResult is the outcome of ending a single listing with TradingAPI.EndItems
+type EndItemResult struct {
+	ItemID       string
+	EndTime      *time.Time
+	AlreadyEnded bool
+	Err          error
+}
@@ -1,10 +1,20 @@
 package ebayapi
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 )
 
+var (
+	// ErrInvalidNotificationSignature is returned when a notification signature does not match the credentials
+	ErrInvalidNotificationSignature = errors.New("ERROR[Notification]: invalid signature")
+	// ErrStaleNotification is returned when a notification timestamp is outside the allowed age
+	ErrStaleNotification = errors.New("ERROR[Notification]: stale timestamp")
+	// ErrDryRunUnsupported is returned in DryRun mode by calls ebay offers no Verify call for
+	ErrDryRunUnsupported = errors.New("ERROR[DryRun]: call has no Verify equivalent")
+)
+
 // EbayErrors holds and handles ebay API errors
 type EbayErrors []ebayResponseError
 
@@ -18,6 +28,18 @@ func (err EbayErrors) Error() string {
 	return strings.Join(errs, ", ")
 }
 
+// Errors returns only the errors with SeverityCode Error, dropping warnings
+func (err EbayErrors) Errors() EbayErrors {
+	var errs EbayErrors
+	for _, e := range err {
+		if e.SeverityCode == "Error" {
+			errs = append(errs, e)
+		}
+	}
+
+	return errs
+}
+
 // RevisionError handles listing revision errors
 func (err EbayErrors) RevisionError() bool {
 	for _, err := range err {
@@ -29,10 +51,37 @@ func (err EbayErrors) RevisionError() bool {
 	return false
 }
 
+// OnlyRevisionErrors reports whether every error with SeverityCode Error is a RevisionError code, meaning the
+// revision changed nothing and nothing else went wrong
+func (err EbayErrors) OnlyRevisionErrors() bool {
+	errs := err.Errors()
+	for _, e := range errs {
+		if !(EbayErrors{e}).RevisionError() {
+			return false
+		}
+	}
+
+	return len(errs) > 0
+}
+
+// listingEndedCodes are the errors of calls on a listing that has ended
+var listingEndedCodes = map[int]bool{291: true, 240: true}
+
 // ListingEnded handles ebay API errors
 func (err EbayErrors) ListingEnded() bool {
 	for _, err := range err {
-		if err.ErrorCode == 291 || err.ErrorCode == 240 {
+		if listingEndedCodes[err.ErrorCode] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AuctionClosed reports ebay refusing to end a listing that is already closed
+func (err EbayErrors) AuctionClosed() bool {
+	for _, err := range err {
+		if err.ErrorCode == 1047 {
 			return true
 		}
 	}
@@ -40,6 +89,33 @@ func (err EbayErrors) ListingEnded() bool {
 	return false
 }
 
+// AlreadyEnded reports whether ending a listing failed only because it had already ended, every error with
+// SeverityCode Error must be a ListingEnded or AuctionClosed error
+func (err EbayErrors) AlreadyEnded() bool {
+	errs := err.Errors()
+	for _, e := range errs {
+		if single := (EbayErrors{e}); !single.ListingEnded() && !single.AuctionClosed() {
+			return false
+		}
+	}
+
+	return len(errs) > 0
+}
+
+// DuplicateTracking reports whether CompleteSale failed only because a tracking number was uploaded before,
+// every error with SeverityCode Error must say so. ebay documents no dedicated code, the messages are matched
+func (err EbayErrors) DuplicateTracking() bool {
+	errs := err.Errors()
+	for _, e := range errs {
+		msg := strings.ToLower(e.ShortMessage + " " + e.LongMessage)
+		if !strings.Contains(msg, "tracking") || !(strings.Contains(msg, "duplicate") || strings.Contains(msg, "already")) {
+			return false
+		}
+	}
+
+	return len(errs) > 0
+}
+
 // ListingDeleted handles ebay API errors
 func (err EbayErrors) ListingDeleted() bool {
 	for _, err := range err {
@@ -51,6 +127,17 @@ func (err EbayErrors) ListingDeleted() bool {
 	return false
 }
 
+// SystemError reports ebay side failures which may succeed when the call is retried
+func (err EbayErrors) SystemError() bool {
+	for _, err := range err {
+		if err.ErrorClassification == "SystemError" {
+			return true
+		}
+	}
+
+	return false
+}
+
 type httpError struct {
 	statusCode int
 	body       []byte
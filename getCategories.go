@@ -0,0 +1,64 @@
+package ebayapi
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// GetCategoriesRequest type - without DetailLevel ReturnAll only the CategoryVersion is returned
+type GetCategoriesRequest struct {
+	XMLName              xml.Name
+	RequesterCredentials *RequesterCredentials
+	CategoryParent       []string `xml:"CategoryParent,omitempty"`
+	CategorySiteID       string   `xml:"CategorySiteID,omitempty"`
+	LevelLimit           int      `xml:"LevelLimit,omitempty"`
+	ViewAllNodes         bool     `xml:"ViewAllNodes,omitempty"`
+	DetailLevel          []string `xml:"DetailLevel,omitempty"`
+	ErrorLanguage        string   `xml:"ErrorLanguage,omitempty"`
+	MessageID            string   `xml:"MessageID,omitempty"`
+	OutputSelector       []string `xml:"OutputSelector,omitempty"`
+	Version              string   `xml:"Version,omitempty"`
+	WarningLevel         string   `xml:"WarningLevel,omitempty"`
+}
+
+// CallName returns name of call
+func (c GetCategoriesRequest) CallName() string {
+	return "GetCategories"
+}
+
+// Body ataches credential and returns XML body
+func (c GetCategoriesRequest) Body(creds *Credentials) interface{} {
+	c.XMLName = xml.Name{
+		Space: "urn:ebay:apis:eBLBaseComponents",
+		Local: c.CallName(),
+	}
+	c.RequesterCredentials = &RequesterCredentials{EBayAuthToken: creds.AuthToken}
+	return c
+}
+
+// ParseResponse retruns response data as EbayResponse object
+func (c GetCategoriesRequest) ParseResponse(r []byte) (EbayResponse, error) {
+	var xmlResponse GetCategoriesResponse
+	err := xml.Unmarshal(r, &xmlResponse)
+
+	return xmlResponse, err
+}
+
+// ResponseErrors returns errors
+func (r GetCategoriesResponse) ResponseErrors() EbayErrors {
+	return r.ebayResponse.Errors
+}
+
+// GetCategoriesResponse type
+type GetCategoriesResponse struct {
+	ebayResponse
+	CategoryArray struct {
+		Category []Category `xml:"Category"`
+	} `xml:"CategoryArray"`
+	CategoryCount        int
+	CategoryVersion      string
+	MinimumReservePrice  float64
+	ReduceReserveAllowed bool
+	ReservePriceAllowed  bool
+	UpdateTime           time.Time
+}
@@ -0,0 +1,189 @@
+package ebayapi
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// ConditionEnabled values of CategoryFeature
+const (
+	ConditionDisabled = "Disabled"
+	ConditionEnabled  = "Enabled"
+	ConditionRequired = "Required"
+)
+
+// GetCategoryFeaturesRequest type - FeatureID limits the returned features, AllFeaturesForCategory returns
+// every feature of CategoryID including the inherited ones
+type GetCategoryFeaturesRequest struct {
+	XMLName                xml.Name
+	RequesterCredentials   *RequesterCredentials
+	AllFeaturesForCategory bool     `xml:"AllFeaturesForCategory,omitempty"`
+	CategoryID             string   `xml:"CategoryID,omitempty"`
+	FeatureID              []string `xml:"FeatureID,omitempty"`
+	LevelLimit             int      `xml:"LevelLimit,omitempty"`
+	ViewAllNodes           bool     `xml:"ViewAllNodes,omitempty"`
+	DetailLevel            []string `xml:"DetailLevel,omitempty"`
+	ErrorLanguage          string   `xml:"ErrorLanguage,omitempty"`
+	MessageID              string   `xml:"MessageID,omitempty"`
+	OutputSelector         []string `xml:"OutputSelector,omitempty"`
+	Version                string   `xml:"Version,omitempty"`
+	WarningLevel           string   `xml:"WarningLevel,omitempty"`
+}
+
+// CallName returns name of call
+func (c GetCategoryFeaturesRequest) CallName() string {
+	return "GetCategoryFeatures"
+}
+
+// Body ataches credential and returns XML body
+func (c GetCategoryFeaturesRequest) Body(creds *Credentials) interface{} {
+	c.XMLName = xml.Name{
+		Space: "urn:ebay:apis:eBLBaseComponents",
+		Local: c.CallName(),
+	}
+	c.RequesterCredentials = &RequesterCredentials{EBayAuthToken: creds.AuthToken}
+	return c
+}
+
+// ParseResponse retruns response data as EbayResponse object
+func (c GetCategoryFeaturesRequest) ParseResponse(r []byte) (EbayResponse, error) {
+	var xmlResponse GetCategoryFeaturesResponse
+	err := xml.Unmarshal(r, &xmlResponse)
+
+	return xmlResponse, err
+}
+
+// ResponseErrors returns errors
+func (r GetCategoryFeaturesResponse) ResponseErrors() EbayErrors {
+	return r.ebayResponse.Errors
+}
+
+// GetCategoryFeaturesResponse type
+type GetCategoryFeaturesResponse struct {
+	ebayResponse
+	Category           []CategoryFeature `xml:"Category"`
+	CategoryVersion    string
+	FeatureDefinitions struct {
+		ListingDurations struct {
+			ListingDuration []ListingDurationDefinition `xml:"ListingDuration"`
+		} `xml:"ListingDurations"`
+	} `xml:"FeatureDefinitions"`
+	SiteDefaults CategoryFeature `xml:"SiteDefaults"`
+	UpdateTime   time.Time
+}
+
+// Features returns the features of a category, falling back to the site defaults for features the
+// category does not override
+func (r GetCategoryFeaturesResponse) Features(categoryID string) CategoryFeature {
+	features := r.SiteDefaults
+	for _, c := range r.Category {
+		if c.CategoryID != categoryID {
+			continue
+		}
+
+		features.CategoryID = c.CategoryID
+		if c.ConditionEnabled != "" {
+			features.ConditionEnabled = c.ConditionEnabled
+		}
+		if c.ConditionValues != nil {
+			features.ConditionValues = c.ConditionValues
+		}
+		if c.ItemSpecificsEnabled != "" {
+			features.ItemSpecificsEnabled = c.ItemSpecificsEnabled
+		}
+		if c.VariationsEnabled != nil {
+			features.VariationsEnabled = c.VariationsEnabled
+		}
+		if c.BestOfferEnabled != nil {
+			features.BestOfferEnabled = c.BestOfferEnabled
+		}
+		if c.ListingDuration != nil {
+			features.ListingDuration = mergeDurationReferences(features.ListingDuration, c.ListingDuration)
+		}
+	}
+
+	return features
+}
+
+// Durations returns the durations allowed for a listing type (e.g. FixedPriceItem) with the given features
+func (r GetCategoryFeaturesResponse) Durations(features CategoryFeature, listingType string) []string {
+	for _, ref := range features.ListingDuration {
+		if ref.Type != listingType {
+			continue
+		}
+		for _, def := range r.FeatureDefinitions.ListingDurations.ListingDuration {
+			if def.DurationSetID == ref.DurationSetID {
+				return def.Duration
+			}
+		}
+	}
+
+	return nil
+}
+
+func mergeDurationReferences(defaults, overrides []ListingDurationReference) []ListingDurationReference {
+	merged := append([]ListingDurationReference{}, overrides...)
+	for _, d := range defaults {
+		overridden := false
+		for _, o := range overrides {
+			if o.Type == d.Type {
+				overridden = true
+				break
+			}
+		}
+		if !overridden {
+			merged = append(merged, d)
+		}
+	}
+
+	return merged
+}
+
+// CategoryFeature type - the listing features of a category, unset values are inherited
+type CategoryFeature struct {
+	CategoryID           string
+	BestOfferEnabled     *bool            `xml:"BestOfferEnabled"`
+	ConditionEnabled     string           `xml:"ConditionEnabled"`
+	ConditionValues      *ConditionValues `xml:"ConditionValues"`
+	ItemSpecificsEnabled string           `xml:"ItemSpecificsEnabled"`
+	ListingDuration      []ListingDurationReference
+	VariationsEnabled    *bool `xml:"VariationsEnabled"`
+}
+
+// AllowsCondition reports whether the condition ID may be used, every condition is allowed when the
+// category does not list its condition values
+func (f CategoryFeature) AllowsCondition(conditionID int) bool {
+	if f.ConditionValues == nil || len(f.ConditionValues.Condition) == 0 {
+		return true
+	}
+	for _, c := range f.ConditionValues.Condition {
+		if c.ID == conditionID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ConditionValues type
+type ConditionValues struct {
+	Condition []Condition `xml:"Condition"`
+}
+
+// Condition type
+type Condition struct {
+	ID          int
+	DisplayName string
+}
+
+// ListingDurationReference type - points to the ListingDurationDefinition of a listing type
+type ListingDurationReference struct {
+	Type          string `xml:"type,attr"`
+	DurationSetID int    `xml:",chardata"`
+}
+
+// ListingDurationDefinition type - a set of durations such as Days_7 or GTC
+type ListingDurationDefinition struct {
+	DurationSetID int      `xml:"durationSetID,attr"`
+	Duration      []string `xml:"Duration"`
+}
@@ -0,0 +1,173 @@
+package ebayapi
+
+import (
+	"encoding/xml"
+	"errors"
+	"strings"
+)
+
+// UsageConstraint values of NameRecommendation validation rules
+const (
+	UsageRequired    = "Required"
+	UsageRecommended = "Recommended"
+	UsageOptional    = "Optional"
+)
+
+// SelectionMode values of NameRecommendation validation rules
+const (
+	SelectionModeFreeText      = "FreeText"
+	SelectionModeSelectionOnly = "SelectionOnly"
+	SelectionModePrefilled     = "Prefilled"
+)
+
+// GetCategorySpecificsRequest type - set up to 100 CategoryIDs
+type GetCategorySpecificsRequest struct {
+	XMLName              xml.Name
+	RequesterCredentials *RequesterCredentials
+	CategoryID           []string `xml:"CategoryID,omitempty"`
+	ExcludeRelationships bool     `xml:"ExcludeRelationships,omitempty"`
+	IncludeConfidence    bool     `xml:"IncludeConfidence,omitempty"`
+	MaxNames             int      `xml:"MaxNames,omitempty"`
+	MaxValuesPerName     int      `xml:"MaxValuesPerName,omitempty"`
+	Name                 string   `xml:"Name,omitempty"`
+	ErrorLanguage        string   `xml:"ErrorLanguage,omitempty"`
+	MessageID            string   `xml:"MessageID,omitempty"`
+	Version              string   `xml:"Version,omitempty"`
+	WarningLevel         string   `xml:"WarningLevel,omitempty"`
+}
+
+// Validate checks the CategoryID limits
+func (c GetCategorySpecificsRequest) Validate() error {
+	if len(c.CategoryID) == 0 {
+		return errors.New("ERROR[GetCategorySpecifics]: CategoryID value missing")
+	}
+	if len(c.CategoryID) > 100 {
+		return errors.New("ERROR[GetCategorySpecifics]: at most 100 CategoryIDs per call")
+	}
+
+	return nil
+}
+
+// CallName returns name of call
+func (c GetCategorySpecificsRequest) CallName() string {
+	return "GetCategorySpecifics"
+}
+
+// Body ataches credential and returns XML body
+func (c GetCategorySpecificsRequest) Body(creds *Credentials) interface{} {
+	c.XMLName = xml.Name{
+		Space: "urn:ebay:apis:eBLBaseComponents",
+		Local: c.CallName(),
+	}
+	c.RequesterCredentials = &RequesterCredentials{EBayAuthToken: creds.AuthToken}
+	return c
+}
+
+// ParseResponse retruns response data as EbayResponse object
+func (c GetCategorySpecificsRequest) ParseResponse(r []byte) (EbayResponse, error) {
+	var xmlResponse GetCategorySpecificsResponse
+	err := xml.Unmarshal(r, &xmlResponse)
+
+	return xmlResponse, err
+}
+
+// ResponseErrors returns errors
+func (r GetCategorySpecificsResponse) ResponseErrors() EbayErrors {
+	return r.ebayResponse.Errors
+}
+
+// GetCategorySpecificsResponse type
+type GetCategorySpecificsResponse struct {
+	ebayResponse
+	Recommendations []Recommendations `xml:"Recommendations"`
+}
+
+// ForCategory returns the recommendations of a requested category
+func (r GetCategorySpecificsResponse) ForCategory(categoryID string) *Recommendations {
+	for i := range r.Recommendations {
+		if r.Recommendations[i].CategoryID == categoryID {
+			return &r.Recommendations[i]
+		}
+	}
+
+	return nil
+}
+
+// Recommendations type - the item specifics of a category
+type Recommendations struct {
+	CategoryID         string
+	NameRecommendation []NameRecommendation `xml:"NameRecommendation"`
+}
+
+// Get returns the recommendation for an item specific name, case insensitive
+func (r Recommendations) Get(name string) *NameRecommendation {
+	for i := range r.NameRecommendation {
+		if strings.EqualFold(r.NameRecommendation[i].Name, name) {
+			return &r.NameRecommendation[i]
+		}
+	}
+
+	return nil
+}
+
+// NameRecommendation type - an item specific (aspect) and its allowed values
+type NameRecommendation struct {
+	Name                string
+	HelpText            string
+	HelpURL             string
+	ValidationRules     RecommendationValidationRules
+	ValueRecommendation []ValueRecommendation `xml:"ValueRecommendation"`
+}
+
+// Required reports whether the item specific must be set to list in the category
+func (n NameRecommendation) Required() bool {
+	return n.ValidationRules.UsageConstraint == UsageRequired
+}
+
+// Recommended reports whether ebay recommends setting the item specific
+func (n NameRecommendation) Recommended() bool {
+	return n.ValidationRules.UsageConstraint == UsageRecommended
+}
+
+// Allows reports whether value may be used, SelectionOnly specifics only accept the recommended values
+func (n NameRecommendation) Allows(value string) bool {
+	if n.ValidationRules.SelectionMode != SelectionModeSelectionOnly {
+		return true
+	}
+	for _, v := range n.ValueRecommendation {
+		if strings.EqualFold(v.Value, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RecommendationValidationRules type
+type RecommendationValidationRules struct {
+	AspectUsage        string
+	Confidence         int
+	MaxValues          int
+	MinValues          int
+	SelectionMode      string
+	UsageConstraint    string
+	ValueFormat        string
+	ValueType          string
+	VariationPicture   string
+	VariationSpecifics string
+	Relationship       []NameValueRelationship `xml:"Relationship"`
+}
+
+// ValueRecommendation type
+type ValueRecommendation struct {
+	Value           string
+	ValidationRules struct {
+		Relationship []NameValueRelationship `xml:"Relationship"`
+	}
+}
+
+// NameValueRelationship type - the parent specific a value depends on
+type NameValueRelationship struct {
+	ParentName  string
+	ParentValue string
+}
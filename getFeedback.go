@@ -0,0 +1,93 @@
+package ebayapi
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// FeedbackType values of GetFeedbackRequest
+const (
+	FeedbackLeft             = "FeedbackLeft"
+	FeedbackReceived         = "FeedbackReceived"
+	FeedbackReceivedAsBuyer  = "FeedbackReceivedAsBuyer"
+	FeedbackReceivedAsSeller = "FeedbackReceivedAsSeller"
+)
+
+// GetFeedbackRequest type - without UserID the feedback of the token's user is returned
+type GetFeedbackRequest struct {
+	XMLName              xml.Name
+	RequesterCredentials *RequesterCredentials
+	UserID               string         `xml:"UserID,omitempty"`
+	FeedbackID           string         `xml:"FeedbackID,omitempty"`
+	ItemID               string         `xml:"ItemID,omitempty"`
+	TransactionID        string         `xml:"TransactionID,omitempty"`
+	OrderLineItemID      string         `xml:"OrderLineItemID,omitempty"`
+	CommentType          []string       `xml:"CommentType,omitempty"`
+	FeedbackType         string         `xml:"FeedbackType,omitempty"`
+	Pagination           *Pagination    `xml:"Pagination,omitempty"`
+	DetailLevel          []string       `xml:"DetailLevel,omitempty"`
+	ErrorLanguage        string         `xml:"ErrorLanguage,omitempty"`
+	MessageID            string         `xml:"MessageID,omitempty"`
+	OutputSelector       OutputSelector `xml:"OutputSelector,omitempty"`
+	Version              string         `xml:"Version,omitempty"`
+	WarningLevel         string         `xml:"WarningLevel,omitempty"`
+}
+
+// CallName returns name of call
+func (c GetFeedbackRequest) CallName() string {
+	return "GetFeedback"
+}
+
+// Body ataches credential and returns XML body
+func (c GetFeedbackRequest) Body(creds *Credentials) interface{} {
+	c.XMLName = xml.Name{
+		Space: "urn:ebay:apis:eBLBaseComponents",
+		Local: c.CallName(),
+	}
+	c.RequesterCredentials = &RequesterCredentials{EBayAuthToken: creds.AuthToken}
+	return c
+}
+
+// ParseResponse retruns response data as EbayResponse object
+func (c GetFeedbackRequest) ParseResponse(r []byte) (EbayResponse, error) {
+	var xmlResponse GetFeedbackResponse
+	err := xml.Unmarshal(r, &xmlResponse)
+
+	return xmlResponse, err
+}
+
+// ResponseErrors returns errors
+func (r GetFeedbackResponse) ResponseErrors() EbayErrors {
+	return r.ebayResponse.Errors
+}
+
+// GetFeedbackResponse type - FeedbackDetailArray is only returned with DetailLevel ReturnAll
+type GetFeedbackResponse struct {
+	ebayResponse
+	FeedbackDetailArray struct {
+		FeedbackDetail []FeedbackDetail `xml:"FeedbackDetail"`
+	} `xml:"FeedbackDetailArray"`
+	FeedbackDetailItemTotal int
+	FeedbackScore           int
+	PaginationResult        PaginationResult
+}
+
+// FeedbackDetail type
+type FeedbackDetail struct {
+	FeedbackID          string
+	CommentingUser      string
+	CommentingUserScore int
+	CommentText         string
+	CommentTime         time.Time
+	CommentType         string
+	FeedbackResponse    string
+	Followup            string
+	Role                string
+	ItemID              string
+	ItemTitle           string
+	ItemPrice           *Price `xml:",omitempty"`
+	TransactionID       string
+	OrderLineItemID     string
+	Countable           bool
+	FeedbackRevised     bool
+}
@@ -1,12 +1,41 @@
 package ebayapi
 
-import "encoding/xml"
+import (
+	"encoding/xml"
+	"errors"
+)
 
-// GetItemRequest obj
+// GetItemRequest obj - set either ItemID or SKU, SKU lookup requires InventoryTrackingMethod SKU listings
 type GetItemRequest struct {
-	XMLName              xml.Name
-	RequesterCredentials *RequesterCredentials
-	ItemID               string
+	XMLName                      xml.Name
+	RequesterCredentials         *RequesterCredentials
+	ItemID                       string              `xml:"ItemID,omitempty"`
+	SKU                          string              `xml:"SKU,omitempty"`
+	VariationSKU                 string              `xml:"VariationSKU,omitempty"`
+	VariationSpecifics           *NameValueListArray `xml:"VariationSpecifics,omitempty"`
+	TransactionID                string              `xml:"TransactionID,omitempty"`
+	IncludeItemCompatibilityList bool                `xml:"IncludeItemCompatibilityList,omitempty"`
+	IncludeItemSpecifics         bool                `xml:"IncludeItemSpecifics,omitempty"`
+	IncludeTaxTable              bool                `xml:"IncludeTaxTable,omitempty"`
+	IncludeWatchCount            bool                `xml:"IncludeWatchCount,omitempty"`
+	DetailLevel                  []string            `xml:"DetailLevel,omitempty"`
+	ErrorLanguage                string              `xml:"ErrorLanguage,omitempty"`
+	MessageID                    string              `xml:"MessageID,omitempty"`
+	OutputSelector               []string            `xml:"OutputSelector,omitempty"`
+	Version                      string              `xml:"Version,omitempty"`
+	WarningLevel                 string              `xml:"WarningLevel,omitempty"`
+}
+
+// Validate checks the item is identified by ItemID or SKU
+func (c GetItemRequest) Validate() error {
+	if c.ItemID == "" && c.SKU == "" {
+		return errors.New("ERROR[GetItem]: ItemID or SKU value missing")
+	}
+	if c.VariationSKU != "" && c.VariationSpecifics != nil {
+		return errors.New("ERROR[GetItem]: VariationSKU and VariationSpecifics are mutually exclusive")
+	}
+
+	return nil
 }
 
 // CallName returns name of call
@@ -36,14 +65,7 @@ func (c GetItemRequest) ParseResponse(r []byte) (EbayResponse, error) {
 type GetItemResponse struct {
 	ebayResponse
 
-	Item struct {
-		ItemID        string
-		Quantity      int64
-		SellingStatus struct {
-			ListingStatus string
-			QuantitySold  int64
-		}
-	}
+	Item Item `xml:"Item"`
 }
 
 // ResponseErrors returns errors
@@ -0,0 +1,54 @@
+package ebayapi
+
+import "encoding/xml"
+
+// GetItemsAwaitingFeedbackRequest type
+type GetItemsAwaitingFeedbackRequest struct {
+	XMLName              xml.Name
+	RequesterCredentials *RequesterCredentials
+	Pagination           *Pagination `xml:"Pagination,omitempty"`
+	Sort                 string      `xml:"Sort,omitempty"`
+	ErrorLanguage        string      `xml:"ErrorLanguage,omitempty"`
+	MessageID            string      `xml:"MessageID,omitempty"`
+	Version              string      `xml:"Version,omitempty"`
+	WarningLevel         string      `xml:"WarningLevel,omitempty"`
+}
+
+// CallName returns name of call
+func (c GetItemsAwaitingFeedbackRequest) CallName() string {
+	return "GetItemsAwaitingFeedback"
+}
+
+// Body ataches credential and returns XML body
+func (c GetItemsAwaitingFeedbackRequest) Body(creds *Credentials) interface{} {
+	c.XMLName = xml.Name{
+		Space: "urn:ebay:apis:eBLBaseComponents",
+		Local: c.CallName(),
+	}
+	c.RequesterCredentials = &RequesterCredentials{EBayAuthToken: creds.AuthToken}
+	return c
+}
+
+// ParseResponse retruns response data as EbayResponse object
+func (c GetItemsAwaitingFeedbackRequest) ParseResponse(r []byte) (EbayResponse, error) {
+	var xmlResponse GetItemsAwaitingFeedbackResponse
+	err := xml.Unmarshal(r, &xmlResponse)
+
+	return xmlResponse, err
+}
+
+// ResponseErrors returns errors
+func (r GetItemsAwaitingFeedbackResponse) ResponseErrors() EbayErrors {
+	return r.ebayResponse.Errors
+}
+
+// GetItemsAwaitingFeedbackResponse type - transactions carry FeedbackReceived when the buyer left feedback
+type GetItemsAwaitingFeedbackResponse struct {
+	ebayResponse
+	ItemsAwaitingFeedback struct {
+		PaginationResult PaginationResult
+		TransactionArray struct {
+			Transaction []Transaction `xml:"Transaction"`
+		} `xml:"TransactionArray"`
+	} `xml:"ItemsAwaitingFeedback"`
+}
@@ -12,15 +12,15 @@ type GetMyeBaySellingRequest struct {
 	// SellingSummary struct {
 	// 	Include bool `xml:"Include"`
 	// } `xml:"SellingSummary"`
-	DetailLevel    []string `xml:",omitempty"`
-	ErrorLanguage  string   `xml:",omitempty"`
-	MessageID      string   `xml:",omitempty"`
-	OutputSelector []string `xml:",omitempty"`
-	Version        string   `xml:",omitempty"`
-	WarningLevel   string   `xml:",omitempty"`
+	DetailLevel    []string       `xml:",omitempty"`
+	ErrorLanguage  string         `xml:",omitempty"`
+	MessageID      string         `xml:",omitempty"`
+	OutputSelector OutputSelector `xml:",omitempty"`
+	Version        string         `xml:",omitempty"`
+	WarningLevel   string         `xml:",omitempty"`
 }
 
-//ActiveListRequest struct
+// ActiveListRequest struct
 type ActiveListRequest struct {
 	Include      bool        `xml:",omitempty"`
 	IncludeNotes string      `xml:",omitempty"`
@@ -31,15 +31,21 @@ type ActiveListRequest struct {
 
 // DefaultOutputSelection selects only order data fields required for order entry
 func (r GetMyeBaySellingRequest) DefaultOutputSelection() *GetMyeBaySellingRequest {
-	r.OutputSelector = []string{
-		"ActiveList.ItemArray.Item.SKU",
-		"ActiveList.ItemArray.Item.ItemID",
-		"ActiveList.ItemArray.Item.Title",
-		"ActiveList.ItemArray.Item.QuantityAvailable",
-		"ActiveList.ItemArray.Item.SellingStatus.CurrentPrice",
-		"ActiveList.PaginationResult.TotalNumberOfEntries",
-		"ActiveList.PaginationResult.TotalNumberOfPages",
-	}
+	r.OutputSelector = NewOutputSelector(
+		SelectActiveItemSKU,
+		SelectActiveItemID,
+		SelectActiveItemTitle,
+		SelectActiveItemQuantity,
+		SelectActiveItemCurrentPrice,
+		SelectActiveListPagination+".TotalNumberOfEntries",
+		SelectActiveListPagination+".TotalNumberOfPages",
+	)
+	return &r
+}
+
+// SelectActiveItemFields selects the active listing fields the given struct declares, plus the pagination fields
+func (r GetMyeBaySellingRequest) SelectActiveItemFields(item interface{}) *GetMyeBaySellingRequest {
+	r.OutputSelector = NewOutputSelector(SelectActiveListPagination).Merge(SelectorsFor(item).Prefix(SelectActiveItem))
 	return &r
 }
 
@@ -71,24 +77,6 @@ func (r GetMyeBaySellingResponse) ResponseErrors() EbayErrors {
 	return r.ebayResponse.Errors
 }
 
-// Item type
-type Item struct {
-	SKU               string `xml:"SKU,omitempty"`
-	StartPrice        *Price `xml:"StartPrice,omitempty"`
-	ItemID            string `xml:"ItemID,omitempty"`
-	Title             string `xml:"Title,omitempty"`
-	QuantityAvailable int    `xml:"QuantityAvailable,omitempty"`
-	SellingStatus     struct {
-		CurrentPrice *Price `xml:"CurrentPrice,omitempty"`
-	} `xml:"SellingStatus,omitempty"`
-}
-
-// Price struct
-type Price struct {
-	Amount     float64 `xml:",chardata"`
-	CurrencyID string  `xml:"currencyID,attr,omitempty"`
-}
-
 // GetMyeBaySellingResponse type
 type GetMyeBaySellingResponse struct {
 	// MANY ADDITIONAL FIELDS AS REQUIRED: https://developer.ebay.com/devzone/xml/docs/reference/ebay/getmyebayselling.html
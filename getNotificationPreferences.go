@@ -0,0 +1,74 @@
+package ebayapi
+
+import "encoding/xml"
+
+// Notification preference levels
+const (
+	PreferenceLevelApplication = "Application"
+	PreferenceLevelUser        = "User"
+	PreferenceLevelUserData    = "UserData"
+	PreferenceLevelEvent       = "Event"
+)
+
+// GetNotificationPreferencesRequest type
+type GetNotificationPreferencesRequest struct {
+	XMLName              xml.Name
+	RequesterCredentials *RequesterCredentials
+	PreferenceLevel      string `xml:"PreferenceLevel"`
+	ErrorLanguage        string `xml:"ErrorLanguage,omitempty"`
+	MessageID            string `xml:"MessageID,omitempty"`
+	Version              string `xml:"Version,omitempty"`
+	WarningLevel         string `xml:"WarningLevel,omitempty"`
+}
+
+// CallName returns name of call
+func (c GetNotificationPreferencesRequest) CallName() string {
+	return "GetNotificationPreferences"
+}
+
+// Body ataches credential and returns XML body
+func (c GetNotificationPreferencesRequest) Body(creds *Credentials) interface{} {
+	c.XMLName = xml.Name{
+		Space: "urn:ebay:apis:eBLBaseComponents",
+		Local: c.CallName(),
+	}
+	c.RequesterCredentials = &RequesterCredentials{EBayAuthToken: creds.AuthToken}
+	return c
+}
+
+// ParseResponse retruns response data as EbayResponse object
+func (c GetNotificationPreferencesRequest) ParseResponse(r []byte) (EbayResponse, error) {
+	var xmlResponse GetNotificationPreferencesResponse
+	err := xml.Unmarshal(r, &xmlResponse)
+
+	return xmlResponse, err
+}
+
+// ResponseErrors returns errors
+func (r GetNotificationPreferencesResponse) ResponseErrors() EbayErrors {
+	return r.ebayResponse.Errors
+}
+
+// GetNotificationPreferencesResponse type - only the sections of the requested PreferenceLevel are set
+type GetNotificationPreferencesResponse struct {
+	ebayResponse
+	ApplicationDeliveryPreferences *ApplicationDeliveryPreferences `xml:"ApplicationDeliveryPreferences"`
+	DeliveryURLName                string                          `xml:"DeliveryURLName"`
+	EventProperty                  []NotificationEventProperty     `xml:"EventProperty"`
+	UserData                       *NotificationUserData           `xml:"UserData"`
+	UserDeliveryPreferenceArray    *UserDeliveryPreferenceArray    `xml:"UserDeliveryPreferenceArray"`
+}
+
+// EventEnabled reports whether the user level preference for the event is Enable
+func (r GetNotificationPreferencesResponse) EventEnabled(event string) bool {
+	if r.UserDeliveryPreferenceArray == nil {
+		return false
+	}
+	for _, n := range r.UserDeliveryPreferenceArray.NotificationEnable {
+		if n.EventType == event {
+			return n.EventEnable == StatusEnable
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,119 @@
+package ebayapi
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// GetNotificationsUsageRequest type - StartTime defaults to 1 day ago and may be up to 3 days back
+type GetNotificationsUsageRequest struct {
+	XMLName              xml.Name
+	RequesterCredentials *RequesterCredentials
+	StartTime            *time.Time `xml:"StartTime,omitempty"`
+	EndTime              *time.Time `xml:"EndTime,omitempty"`
+	ItemID               string     `xml:"ItemID,omitempty"`
+	ErrorLanguage        string     `xml:"ErrorLanguage,omitempty"`
+	MessageID            string     `xml:"MessageID,omitempty"`
+	Version              string     `xml:"Version,omitempty"`
+	WarningLevel         string     `xml:"WarningLevel,omitempty"`
+}
+
+// CallName returns name of call
+func (c GetNotificationsUsageRequest) CallName() string {
+	return "GetNotificationsUsage"
+}
+
+// Body ataches credential and returns XML body
+func (c GetNotificationsUsageRequest) Body(creds *Credentials) interface{} {
+	c.XMLName = xml.Name{
+		Space: "urn:ebay:apis:eBLBaseComponents",
+		Local: c.CallName(),
+	}
+	c.RequesterCredentials = &RequesterCredentials{EBayAuthToken: creds.AuthToken}
+	return c
+}
+
+// ParseResponse retruns response data as EbayResponse object
+func (c GetNotificationsUsageRequest) ParseResponse(r []byte) (EbayResponse, error) {
+	var xmlResponse GetNotificationsUsageResponse
+	err := xml.Unmarshal(r, &xmlResponse)
+
+	return xmlResponse, err
+}
+
+// ResponseErrors returns errors
+func (r GetNotificationsUsageResponse) ResponseErrors() EbayErrors {
+	return r.ebayResponse.Errors
+}
+
+// GetNotificationsUsageResponse type
+type GetNotificationsUsageResponse struct {
+	ebayResponse
+	StartTime                time.Time
+	EndTime                  time.Time
+	NotificationDetailsArray struct {
+		NotificationDetails []NotificationDetails `xml:"NotificationDetails"`
+	} `xml:"NotificationDetailsArray"`
+	MarkUpMarkDownHistory struct {
+		MarkUpMarkDownEvent []MarkUpMarkDownEvent `xml:"MarkUpMarkDownEvent"`
+	} `xml:"MarkUpMarkDownHistory"`
+	NotificationStatistics NotificationStatistics `xml:"NotificationStatistics"`
+}
+
+// NotificationDetails type - delivery status of a single notification, only returned when ItemID is set
+type NotificationDetails struct {
+	DeliveryStatus  string
+	DeliveryTime    time.Time
+	DeliveryURL     string
+	DeliveryURLName string
+	ErrorMessage    string
+	ExpirationTime  time.Time
+	NextRetryTime   time.Time
+	ReferenceID     string
+	Retries         int
+	Type            string
+}
+
+// MarkUpMarkDownEvent type - ebay marks an application down after repeated delivery failures
+type MarkUpMarkDownEvent struct {
+	Reason string
+	Time   time.Time
+	Type   string
+}
+
+// NotificationStatistics type
+type NotificationStatistics struct {
+	DeliveredCount     int
+	ErrorCount         int
+	ExpiredCount       int
+	QueuedNewCount     int
+	QueuedPendingCount int
+}
+
+// FailedDeliveries returns the notifications ebay failed to deliver
+func (r GetNotificationsUsageResponse) FailedDeliveries() []NotificationDetails {
+	var failed []NotificationDetails
+	for _, n := range r.NotificationDetailsArray.NotificationDetails {
+		if n.DeliveryStatus == "Failed" {
+			failed = append(failed, n)
+		}
+	}
+
+	return failed
+}
+
+// MarkedDown reports whether ebay has stopped delivering to the application URL
+func (r GetNotificationsUsageResponse) MarkedDown() bool {
+	events := r.MarkUpMarkDownHistory.MarkUpMarkDownEvent
+	if len(events) == 0 {
+		return false
+	}
+
+	latest := events[0]
+	for _, e := range events[1:] {
+		if e.Time.After(latest.Time) {
+			latest = e
+		}
+	}
+	return latest.Type == "MarkDown"
+}
@@ -2,29 +2,53 @@ package ebayapi
 
 import (
 	"encoding/xml"
+	"errors"
+	"fmt"
 	"time"
 )
 
+// MaxOrderIDs is the most OrderIDs a GetOrders call accepts
+const MaxOrderIDs = 100
+
+// MaxEntriesPerPage is the largest GetOrders page size
+const MaxEntriesPerPage = 100
+
+// MaxOrderCreateTimeWindow is the longest CreateTimeFrom/CreateTimeTo range GetOrders accepts
+const MaxOrderCreateTimeWindow = 90 * 24 * time.Hour
+
+// OrderRole values
+const (
+	OrderRoleBuyer  = "Buyer"
+	OrderRoleSeller = "Seller"
+)
+
+// SortingOrder values
+const (
+	SortingOrderAscending  = "Ascending"
+	SortingOrderDescending = "Descending"
+)
+
 // GetOrdersRequest type
 type GetOrdersRequest struct {
 	XMLName              xml.Name
 	RequesterCredentials *RequesterCredentials
-	CreateTimeFrom       *time.Time    `xml:",omitempty"`
-	CreateTimeTo         *time.Time    `xml:",omitempty"`
-	IncludeFinalValueFee bool          `xml:",omitempty"`
-	ModTimeFrom          *time.Time    `xml:",omitempty"`
-	ModTimeTo            *time.Time    `xml:",omitempty"`
-	NumberOfDays         int           `xml:",omitempty"`
-	OrderIDArray         *OrderIDArray `xml:",omitempty"`
-	OrderStatus          string        `xml:",omitempty"`
-	Pagination           *Pagination   `xml:",omitempty"`
-	SortingOrder         string        `xml:",omitempty"`
-	DetailLevel          []string      `xml:",omitempty"`
-	ErrorLanguage        string        `xml:",omitempty"`
-	MessageID            string        `xml:",omitempty"`
-	OutputSelector       []string      `xml:",omitempty"`
-	Version              string        `xml:",omitempty"`
-	WarningLevel         string        `xml:",omitempty"`
+	CreateTimeFrom       *time.Time     `xml:",omitempty"`
+	CreateTimeTo         *time.Time     `xml:",omitempty"`
+	IncludeFinalValueFee bool           `xml:",omitempty"`
+	ModTimeFrom          *time.Time     `xml:",omitempty"`
+	ModTimeTo            *time.Time     `xml:",omitempty"`
+	NumberOfDays         int            `xml:",omitempty"`
+	OrderIDArray         *OrderIDArray  `xml:",omitempty"`
+	OrderRole            string         `xml:",omitempty"`
+	OrderStatus          string         `xml:",omitempty"`
+	Pagination           *Pagination    `xml:",omitempty"`
+	SortingOrder         string         `xml:",omitempty"`
+	DetailLevel          []string       `xml:",omitempty"`
+	ErrorLanguage        string         `xml:",omitempty"`
+	MessageID            string         `xml:",omitempty"`
+	OutputSelector       OutputSelector `xml:",omitempty"`
+	Version              string         `xml:",omitempty"`
+	WarningLevel         string         `xml:",omitempty"`
 }
 
 // OrderIDArray struct
@@ -32,34 +56,223 @@ type OrderIDArray struct {
 	OrderIDs []string `xml:"OrderID,omitempty"`
 }
 
+// Validate checks the filters ebay rejects or silently ignores when combined: OrderIDArray, NumberOfDays,
+// CreateTime and ModTime ranges are mutually exclusive
+func (c GetOrdersRequest) Validate() error {
+	createTime := c.CreateTimeFrom != nil || c.CreateTimeTo != nil
+	modTime := c.ModTimeFrom != nil || c.ModTimeTo != nil
+
+	var filters []string
+	if c.OrderIDArray != nil && len(c.OrderIDArray.OrderIDs) > 0 {
+		filters = append(filters, "OrderIDArray")
+	}
+	if c.NumberOfDays != 0 {
+		filters = append(filters, "NumberOfDays")
+	}
+	if createTime {
+		filters = append(filters, "CreateTimeFrom/CreateTimeTo")
+	}
+	if modTime {
+		filters = append(filters, "ModTimeFrom/ModTimeTo")
+	}
+	if len(filters) > 1 {
+		return fmt.Errorf("ERROR[GetOrders]: %s and %s can't be combined", filters[0], filters[1])
+	}
+
+	if c.OrderIDArray != nil && len(c.OrderIDArray.OrderIDs) > MaxOrderIDs {
+		return fmt.Errorf("ERROR[GetOrders]: %d OrderIDs requested, at most %d allowed", len(c.OrderIDArray.OrderIDs), MaxOrderIDs)
+	}
+	if c.NumberOfDays < 0 || c.NumberOfDays > 30 {
+		return fmt.Errorf("ERROR[GetOrders]: NumberOfDays %d must be 0 (unset) or 1-30", c.NumberOfDays)
+	}
+	if createTime {
+		if err := validateTimeRange("CreateTime", c.CreateTimeFrom, c.CreateTimeTo, MaxOrderCreateTimeWindow); err != nil {
+			return err
+		}
+	}
+	if modTime {
+		if err := validateTimeRange("ModTime", c.ModTimeFrom, c.ModTimeTo, MaxOrderModTimeWindow); err != nil {
+			return err
+		}
+	}
+
+	switch c.OrderStatus {
+	case "", OrderStatusActive, OrderStatusAll, OrderStatusCancelled, OrderStatusCancelPending, OrderStatusCompleted, OrderStatusInactive:
+	default:
+		return fmt.Errorf("ERROR[GetOrders]: invalid OrderStatus %q", c.OrderStatus)
+	}
+	switch c.OrderRole {
+	case "", OrderRoleBuyer, OrderRoleSeller:
+	default:
+		return fmt.Errorf("ERROR[GetOrders]: invalid OrderRole %q", c.OrderRole)
+	}
+	switch c.SortingOrder {
+	case "", SortingOrderAscending, SortingOrderDescending:
+	default:
+		return fmt.Errorf("ERROR[GetOrders]: invalid SortingOrder %q", c.SortingOrder)
+	}
+
+	if p := c.Pagination; p != nil {
+		if p.EntriesPerPage < 0 || p.EntriesPerPage > MaxEntriesPerPage {
+			return fmt.Errorf("ERROR[GetOrders]: EntriesPerPage %d must be 0 (unset) or 1-%d", p.EntriesPerPage, MaxEntriesPerPage)
+		}
+		if p.PageNumber < 0 {
+			return fmt.Errorf("ERROR[GetOrders]: invalid PageNumber %d", p.PageNumber)
+		}
+	}
+
+	return nil
+}
+
+// validateTimeRange checks the From time is set and before To, and that the range fits maxRange when not 0
+func validateTimeRange(name string, from, to *time.Time, maxRange time.Duration) error {
+	if from == nil {
+		return errors.New("ERROR[GetOrders]: " + name + "To requires " + name + "From")
+	}
+
+	end := time.Now()
+	if to != nil {
+		end = *to
+	}
+	if !from.Before(end) {
+		return errors.New("ERROR[GetOrders]: " + name + "From must be before " + name + "To")
+	}
+	if maxRange > 0 && end.Sub(*from) > maxRange {
+		return fmt.Errorf("ERROR[GetOrders]: %s range longer than %d days", name, int(maxRange.Hours()/24))
+	}
+
+	return nil
+}
+
+// GetOrdersBuilder builds a GetOrdersRequest validated before any call is made
+type GetOrdersBuilder struct {
+	req GetOrdersRequest
+}
+
+// NewGetOrdersBuilder starts an empty GetOrders request
+func NewGetOrdersBuilder() *GetOrdersBuilder {
+	return &GetOrdersBuilder{}
+}
+
+// SetOrderIDs selects orders by ID, at most 100
+func (b *GetOrdersBuilder) SetOrderIDs(orderIDs ...string) *GetOrdersBuilder {
+	b.req.OrderIDArray = &OrderIDArray{OrderIDs: orderIDs}
+	return b
+}
+
+// SetNumberOfDays selects orders created or modified in the last 1 to 30 days
+func (b *GetOrdersBuilder) SetNumberOfDays(days int) *GetOrdersBuilder {
+	b.req.NumberOfDays = days
+	return b
+}
+
+// SetCreateTime selects orders created in the range, a zero to means now
+func (b *GetOrdersBuilder) SetCreateTime(from, to time.Time) *GetOrdersBuilder {
+	b.req.CreateTimeFrom, b.req.CreateTimeTo = timeRange(from, to)
+	return b
+}
+
+// SetModTime selects orders modified in the range of at most 30 days, a zero to means now
+func (b *GetOrdersBuilder) SetModTime(from, to time.Time) *GetOrdersBuilder {
+	b.req.ModTimeFrom, b.req.ModTimeTo = timeRange(from, to)
+	return b
+}
+
+func timeRange(from, to time.Time) (*time.Time, *time.Time) {
+	if to.IsZero() {
+		return &from, nil
+	}
+	return &from, &to
+}
+
+// SetOrderStatus filters by OrderStatus, e.g. OrderStatusCompleted
+func (b *GetOrdersBuilder) SetOrderStatus(status string) *GetOrdersBuilder {
+	b.req.OrderStatus = status
+	return b
+}
+
+// SetOrderRole selects orders we sold (OrderRoleSeller) or bought (OrderRoleBuyer)
+func (b *GetOrdersBuilder) SetOrderRole(role string) *GetOrdersBuilder {
+	b.req.OrderRole = role
+	return b
+}
+
+// SetSortingOrder sorts the orders by creation time
+func (b *GetOrdersBuilder) SetSortingOrder(order string) *GetOrdersBuilder {
+	b.req.SortingOrder = order
+	return b
+}
+
+// SetPagination selects a page of at most 100 entries
+func (b *GetOrdersBuilder) SetPagination(pageNumber, entriesPerPage int) *GetOrdersBuilder {
+	b.req.Pagination = &Pagination{PageNumber: pageNumber, EntriesPerPage: entriesPerPage}
+	return b
+}
+
+// SetIncludeFinalValueFee includes the final value fee of each transaction
+func (b *GetOrdersBuilder) SetIncludeFinalValueFee(include bool) *GetOrdersBuilder {
+	b.req.IncludeFinalValueFee = include
+	return b
+}
+
+// SetDetailLevel sets the detail level, e.g. ReturnAll
+func (b *GetOrdersBuilder) SetDetailLevel(detailLevel ...string) *GetOrdersBuilder {
+	b.req.DetailLevel = detailLevel
+	return b
+}
+
+// SetOutputSelector limits the returned fields
+func (b *GetOrdersBuilder) SetOutputSelector(selectors ...string) *GetOrdersBuilder {
+	b.req.OutputSelector = NewOutputSelector(selectors...)
+	return b
+}
+
+// Build returns the request, or the first rule it breaks
+func (b *GetOrdersBuilder) Build() (*GetOrdersRequest, error) {
+	req := b.req
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	return &req, nil
+}
+
 // DefaultOutputSelection selects only order data fields required for order entry
 func (c GetOrdersRequest) DefaultOutputSelection() *GetOrdersRequest {
-	c.DetailLevel = []string{"ReturnAll"}
-	c.OutputSelector = []string{
-		"HasMoreOrders",
-		"PageNumber",
-		"PaginationResult",
-		"OrderArray.Order.OrderID",
-		"OrderArray.Order.OrderStatus",
-		"OrderArray.Order.ShippedTime",
-		"OrderArray.Order.TransactionArray.Transaction.Buyer.UserFirstName",
-		"OrderArray.Order.TransactionArray.Transaction.Buyer.UserLastName",
-		"OrderArray.Order.TransactionArray.Transaction.Buyer.Email",
-		"OrderArray.Order.MonetaryDetails.Payments.Payment.ReferenceID",
-		"OrderArray.Order.ShippingDetails.SellingManagerSalesRecordNumber",
-		"OrderArray.Order.Total",
-		"OrderArray.Order.ShippingAddress.Name",
-		"OrderArray.Order.ShippingAddress.Street1",
-		"OrderArray.Order.ShippingAddress.Street2",
-		"OrderArray.Order.ShippingAddress.CityName",
-		"OrderArray.Order.ShippingAddress.StateOrProvince",
-		"OrderArray.Order.ShippingAddress.PostalCode",
-		"OrderArray.Order.ShippingAddress.Phone",
-		"OrderArray.Order.TransactionArray.Transaction.Item.SKU",
-		"OrderArray.Order.TransactionArray.Transaction.TransactionID",
-		"OrderArray.Order.TransactionArray.Transaction.QuantityPurchased",
-		"OrderArray.Order.MonetaryDetails.Payments.Payment.ReferenceID",
-	}
+	c.DetailLevel = []string{DetailLevelReturnAll}
+	c.OutputSelector = NewOutputSelector(
+		SelectHasMoreOrders,
+		SelectPageNumber,
+		SelectPaginationResult,
+		SelectOrderID,
+		SelectOrderStatus,
+		SelectOrderShippedTime,
+		SelectTransactionBuyer+".UserFirstName",
+		SelectTransactionBuyer+".UserLastName",
+		SelectTransactionBuyer+".Email",
+		SelectOrderPaymentReferenceID,
+		SelectOrderSalesRecordNumber,
+		SelectOrderTotal,
+		SelectOrderShippingAddress+".Name",
+		SelectOrderShippingAddress+".Street1",
+		SelectOrderShippingAddress+".Street2",
+		SelectOrderShippingAddress+".CityName",
+		SelectOrderShippingAddress+".StateOrProvince",
+		SelectOrderShippingAddress+".PostalCode",
+		SelectOrderShippingAddress+".Phone",
+		SelectTransactionSKU,
+		SelectTransactionID,
+		SelectTransactionQuantity,
+	)
+	return &c
+}
+
+// SelectOrderFields selects the order fields the given struct declares, plus the pagination fields, e.g.
+// SelectOrderFields(myOrder{}) for a type with only OrderID and Total. The orders are still returned as Order
+// with only the selected fields set, the struct just names them with Order's xml names
+func (c GetOrdersRequest) SelectOrderFields(order interface{}) *GetOrdersRequest {
+	c.DetailLevel = []string{DetailLevelReturnAll}
+	c.OutputSelector = NewOutputSelector(SelectHasMoreOrders, SelectPageNumber, SelectPaginationResult).
+		Merge(SelectorsFor(order).Prefix(SelectOrder))
 	return &c
 }
 
@@ -119,256 +332,3 @@ type GetOrdersResponse struct {
 	PageNumber               int
 	ReturnedOrderCountActual int
 }
-
-// Order ebay api type - uncomment fields as needed
-type Order struct {
-	OrderID         string
-	OrderStatus     string
-	BuyerUserID     string
-	CreatedTime     time.Time
-	ShippedTime     *time.Time `xml:",omitempty"`
-	MonetaryDetails struct {
-		Payments struct {
-			Payment struct {
-				ReferenceID string `xml:"ReferenceID"`
-				// FeeOrCreditAmount struct {
-				// 	Amount     float64 `xml:",chardata"`
-				// 	CurrencyID string  `xml:"currencyID,attr"`
-				// } `xml:"FeeOrCreditAmount"`
-				// Payee         string `xml:"Payee"`
-				// Payer         string `xml:"Payer"`
-				// PaymentAmount struct {
-				// 	Amount     float64 `xml:",chardata"`
-				// 	CurrencyID string  `xml:"currencyID,attr"`
-				// } `xml:"PaymentAmount"`
-				// PaymentReferenceID string    `xml:"PaymentReferenceID"`
-				// PaymentStatus      string    `xml:"PaymentStatus"`
-				// PaymentTime        time.Time `xml:"PaymentTime"`
-			} `xml:"Payment"`
-		} `xml:"Payments"`
-		// Refunds struct {
-		// 	Refund struct {
-		// 		FeeOrCreditAmount struct {
-		// 			Amount     float64 `xml:",chardata"`
-		// 			CurrencyID string  `xml:"currencyID,attr"`
-		// 		} `xml:"FeeOrCreditAmount"`
-		// 		ReferenceID  string `xml:"ReferenceID"`
-		// 		RefundAmount struct {
-		// 			Amount     float64 `xml:",chardata"`
-		// 			CurrencyID string  `xml:"currencyID,attr"`
-		// 		} `xml:"RefundAmount"`
-		// 		RefundStatus string `xml:"RefundStatus"`
-		// 		RefundTime   string `xml:"RefundTime"`
-		// 		RefundTo     string `xml:"RefundTo"`
-		// 		RefundType   string `xml:"RefundType"`
-		// 	} `xml:"Refund"`
-		// } `xml:"Refunds"`
-	} `xml:"MonetaryDetails"`
-	ShippingDetails struct {
-		SellingManagerSalesRecordNumber string
-		// SalesTax struct {
-		// 	ShippingIncludedInTax bool
-		// 	SalesTaxPercent       float64
-		// 	SalesTaxState         string
-		// 	SalesTaxAmount        struct {
-		// 		Amount     float64 `xml:",chardata"`
-		// 		CurrencyID string  `xml:"currencyID,attr"`
-		// 	} `xml:"SalesTaxAmount"`
-		// } `xml:"SalesTax"`
-		// ShippingServiceOptions struct {
-		// 	ShippingService     string
-		// 	ShippingServiceCost struct {
-		// 		Amount     float64 `xml:",chardata"`
-		// 		CurrencyID string  `xml:"currencyID,attr"`
-		// 	} `xml:"ShippingServiceCost"`
-		// 	ShippingServicePriority int
-		// 	ExpeditedService        bool
-		// 	ShippingTimeMin         int
-		// 	ShippingTimeMax         int
-		// } `xml:"ShippingServiceOptions"`
-		//GetItFast                       bool
-	} `xml:"ShippingDetails"`
-	ShippingAddress struct {
-		Name              string
-		Street1           string
-		Street2           string
-		CityName          string
-		StateOrProvince   string
-		Country           string
-		CountryName       string
-		Phone             string
-		PostalCode        string
-		AddressID         string
-		AddressOwner      string
-		ExternalAddressID string
-	} `xml:"ShippingAddress"`
-	Total struct {
-		Amount     float64 `xml:",chardata"`
-		CurrencyID string  `xml:"currencyID,attr"`
-	} `xml:"Total"`
-	TransactionArray struct {
-		Transaction []struct {
-			TransactionID string
-			Buyer         struct {
-				Email         string
-				UserFirstName string
-				UserLastName  string
-				// VATStatus     string
-			} `xml:"Buyer"`
-			ShippingDetails struct {
-				SellingManagerSalesRecordNumber int
-			} `xml:"ShippingDetails"`
-			CreatedDate time.Time
-			Item        struct {
-				ItemID               string
-				Site                 string
-				Title                string
-				SKU                  string
-				ConditionID          int
-				ConditionDisplayName string
-			} `xml:"Item"`
-			QuantityPurchased int32
-			OrderLineItemID   string
-			// Status            struct {
-			// 	PaymentHoldStatus string
-			// 	InquiryStatus     string
-			// 	ReturnStatus      string
-			// } `xml:"Status"`
-			// TransactionPrice struct {
-			// 	Amount     float64 `xml:",chardata"`
-			// 	CurrencyID string  `xml:"currencyID,attr"`
-			// } `xml:"TransactionPrice"`
-			// EBayCollectAndRemitTax  bool `xml:"eBayCollectAndRemitTax"`
-			// ShippingServiceSelected struct {
-			// 	ShippingPackageInfo struct {
-			// 		EstimatedDeliveryTimeMin time.Time
-			// 		EstimatedDeliveryTimeMax time.Time
-			// 		HandleByTime             time.Time
-			// 	} `xml:"ShippingPackageInfo"`
-			// } `xml:"ShippingServiceSelected"`
-			// TransactionSiteID        string
-			// Platform                 string
-			// EBayCollectAndRemitTaxes struct {
-			// 	TotalTaxAmount struct {
-			// 		Amount     float64 `xml:",chardata"`
-			// 		CurrencyID string  `xml:"currencyID,attr"`
-			// 	} `xml:"TotalTaxAmount"`
-			// 	TaxDetails []struct {
-			// 		Imposition     string
-			// 		TaxDescription string
-			// 		TaxAmount      struct {
-			// 			Amount     float64 `xml:",chardata"`
-			// 			CurrencyID string  `xml:"currencyID,attr"`
-			// 		} `xml:"TaxAmount"`
-			// 		TaxOnSubtotalAmount struct {
-			// 			Amount     float64 `xml:",chardata"`
-			// 			CurrencyID string  `xml:"currencyID,attr"`
-			// 		} `xml:"TaxOnSubtotalAmount"`
-			// 		TaxOnShippingAmount struct {
-			// 			Amount     float64 `xml:",chardata"`
-			// 			CurrencyID string  `xml:"currencyID,attr"`
-			// 		} `xml:"TaxOnShippingAmount"`
-			// 		TaxOnHandlingAmount struct {
-			// 			Amount     float64 `xml:",chardata"`
-			// 			CurrencyID string  `xml:"currencyID,attr"`
-			// 		} `xml:"TaxOnHandlingAmount"`
-			// 	} `xml:"TaxDetails"`
-			// } `xml:"eBayCollectAndRemitTaxes"`
-			// ActualShippingCost struct {
-			// 	Amount     float64 `xml:",chardata"`
-			// 	CurrencyID string  `xml:"currencyID,attr"`
-			// } `xml:"ActualShippingCost"`
-			// ActualHandlingCost struct {
-			// 	Amount     float64 `xml:",chardata"`
-			// 	CurrencyID string  `xml:"currencyID,attr"`
-			// } `xml:"ActualHandlingCost"`
-			// ExtendedOrderID     string
-			ExternalTransaction struct {
-				ExternalTransactionID     string `xml:"ExternalTransactionID"`
-				ExternalTransactionStatus string `xml:"ExternalTransactionStatus"`
-				ExternalTransactionTime   string `xml:"ExternalTransactionTime"`
-				FeeOrCreditAmount         struct {
-					Amount     string `xml:",chardata"`
-					CurrencyID string `xml:"currencyID,attr"`
-				} `xml:"FeeOrCreditAmount"`
-				PaymentOrRefundAmount struct {
-					Amount     string `xml:",chardata"`
-					CurrencyID string `xml:"currencyID,attr"`
-				} `xml:"PaymentOrRefundAmount"`
-			} `xml:"ExternalTransaction"`
-			// EBayPlusTransaction bool `xml:"eBayPlusTransaction"`
-			// GuaranteedShipping  bool
-			// GuaranteedDelivery  bool
-			// Taxes               struct {
-			// 	TotalTaxAmount struct {
-			// 		Amount     float64 `xml:",chardata"`
-			// 		CurrencyID string  `xml:"currencyID,attr"`
-			// 	} `xml:"TotalTaxAmount"`
-			// 	TaxDetails []struct {
-			// 		Imposition     string
-			// 		TaxDescription string
-			// 		TaxAmount      struct {
-			// 			Amount     float64 `xml:",chardata"`
-			// 			CurrencyID string  `xml:"currencyID,attr"`
-			// 		} `xml:"TaxAmount"`
-			// 		TaxOnSubtotalAmount struct {
-			// 			Amount     float64 `xml:",chardata"`
-			// 			CurrencyID string  `xml:"currencyID,attr"`
-			// 		} `xml:"TaxOnSubtotalAmount"`
-			// 		TaxOnShippingAmount struct {
-			// 			Amount     float64 `xml:",chardata"`
-			// 			CurrencyID string  `xml:"currencyID,attr"`
-			// 		} `xml:"TaxOnShippingAmount"`
-			// 		TaxOnHandlingAmount struct {
-			// 			Amount     float64 `xml:",chardata"`
-			// 			CurrencyID string  `xml:"currencyID,attr"`
-			// 		} `xml:"TaxOnHandlingAmount"`
-			// 	} `xml:"TaxDetails"`
-			// } `xml:"Taxes"`
-		} `xml:"Transaction"`
-	} `xml:"TransactionArray"`
-	// PaymentMethods  string
-	// SellerEmail     string
-	// ShippingServiceSelected struct {
-	// 	ShippingService     string
-	// 	ShippingServiceCost struct {
-	// 		Amount     float64 `xml:",chardata"`
-	// 		CurrencyID string  `xml:"currencyID,attr"`
-	// 	} `xml:"ShippingServiceCost"`
-	// } `xml:"ShippingServiceSelected"`
-	// Subtotal struct {
-	// 	Amount     float64 `xml:",chardata"`
-	// 	CurrencyID string  `xml:"currencyID,attr"`
-	// } `xml:"Subtotal"`
-	// EBayCollectAndRemitTax bool `xml:"eBayCollectAndRemitTax"`
-	// PaidTime                            time.Time
-	// IntegratedMerchantCreditCardEnabled bool
-	// EIASToken                           string
-	// PaymentHoldStatus                   string
-	// IsMultiLegShipping                  bool
-	// SellerUserID                        string
-	// SellerEIASToken                     string
-	// CancelStatus                        string
-	// ExtendedOrderID                     string
-	// ContainseBayPlusTransaction         bool
-	// AdjustmentAmount struct {
-	// 	Amount     float64 `xml:",chardata"`
-	// 	CurrencyID string  `xml:"currencyID,attr"`
-	// } `xml:"AdjustmentAmount"`
-	// AmountPaid struct {
-	// 	Amount     float64 `xml:",chardata"`
-	// 	CurrencyID string  `xml:"currencyID,attr"`
-	// } `xml:"AmountPaid"`
-	// AmountSaved struct {
-	// 	Amount     float64 `xml:",chardata"`
-	// 	CurrencyID string  `xml:"currencyID,attr"`
-	// } `xml:"AmountSaved"`
-	// CheckoutStatus struct {
-	// 	EBayPaymentStatus                   string `xml:"eBayPaymentStatus"`
-	// 	LastModifiedTime                    time.Time
-	// 	PaymentMethod                       string
-	// 	Status                              string
-	// 	IntegratedMerchantCreditCardEnabled bool
-	// 	PaymentInstrument                   string
-	// }
-}
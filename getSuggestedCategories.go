@@ -0,0 +1,57 @@
+package ebayapi
+
+import "encoding/xml"
+
+// GetSuggestedCategoriesRequest type
+type GetSuggestedCategoriesRequest struct {
+	XMLName              xml.Name
+	RequesterCredentials *RequesterCredentials
+	Query                string `xml:"Query"`
+	ErrorLanguage        string `xml:"ErrorLanguage,omitempty"`
+	MessageID            string `xml:"MessageID,omitempty"`
+	Version              string `xml:"Version,omitempty"`
+	WarningLevel         string `xml:"WarningLevel,omitempty"`
+}
+
+// CallName returns name of call
+func (c GetSuggestedCategoriesRequest) CallName() string {
+	return "GetSuggestedCategories"
+}
+
+// Body ataches credential and returns XML body
+func (c GetSuggestedCategoriesRequest) Body(creds *Credentials) interface{} {
+	c.XMLName = xml.Name{
+		Space: "urn:ebay:apis:eBLBaseComponents",
+		Local: c.CallName(),
+	}
+	c.RequesterCredentials = &RequesterCredentials{EBayAuthToken: creds.AuthToken}
+	return c
+}
+
+// ParseResponse retruns response data as EbayResponse object
+func (c GetSuggestedCategoriesRequest) ParseResponse(r []byte) (EbayResponse, error) {
+	var xmlResponse GetSuggestedCategoriesResponse
+	err := xml.Unmarshal(r, &xmlResponse)
+
+	return xmlResponse, err
+}
+
+// ResponseErrors returns errors
+func (r GetSuggestedCategoriesResponse) ResponseErrors() EbayErrors {
+	return r.ebayResponse.Errors
+}
+
+// GetSuggestedCategoriesResponse type
+type GetSuggestedCategoriesResponse struct {
+	ebayResponse
+	CategoryCount          int
+	SuggestedCategoryArray struct {
+		SuggestedCategory []SuggestedCategory `xml:"SuggestedCategory"`
+	} `xml:"SuggestedCategoryArray"`
+}
+
+// SuggestedCategory type - PercentItemFound is the share of matching items listed in the category
+type SuggestedCategory struct {
+	Category         Category
+	PercentItemFound int
+}
@@ -0,0 +1,237 @@
+package ebayapi
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// DetailName values of GeteBayDetails, each selects the EbayDetails field of the same name
+const (
+	DetailCountry                 = "CountryDetails"
+	DetailCurrency                = "CurrencyDetails"
+	DetailDispatchTimeMax         = "DispatchTimeMaxDetails"
+	DetailExcludeShippingLocation = "ExcludeShippingLocationDetails"
+	DetailReturnPolicy            = "ReturnPolicyDetails"
+	DetailShippingCarrier         = "ShippingCarrierDetails"
+	DetailShippingLocation        = "ShippingLocationDetails"
+	DetailShippingPackage         = "ShippingPackageDetails"
+	DetailShippingService         = "ShippingServiceDetails"
+	DetailSite                    = "SiteDetails"
+	DetailTimeZone                = "TimeZoneDetails"
+)
+
+// DefaultDetailNames are the details cached by SyncEbayDetails when no names are given
+var DefaultDetailNames = []string{
+	DetailCountry,
+	DetailCurrency,
+	DetailDispatchTimeMax,
+	DetailExcludeShippingLocation,
+	DetailReturnPolicy,
+	DetailShippingCarrier,
+	DetailShippingLocation,
+	DetailShippingPackage,
+	DetailShippingService,
+	DetailSite,
+	DetailTimeZone,
+}
+
+// GeteBayDetailsRequest type - without DetailName all details of the site are returned
+type GeteBayDetailsRequest struct {
+	XMLName              xml.Name
+	RequesterCredentials *RequesterCredentials
+	DetailName           []string `xml:"DetailName,omitempty"`
+	ErrorLanguage        string   `xml:"ErrorLanguage,omitempty"`
+	MessageID            string   `xml:"MessageID,omitempty"`
+	OutputSelector       []string `xml:"OutputSelector,omitempty"`
+	Version              string   `xml:"Version,omitempty"`
+	WarningLevel         string   `xml:"WarningLevel,omitempty"`
+}
+
+// CallName returns name of call
+func (c GeteBayDetailsRequest) CallName() string {
+	return "GeteBayDetails"
+}
+
+// Body ataches credential and returns XML body
+func (c GeteBayDetailsRequest) Body(creds *Credentials) interface{} {
+	c.XMLName = xml.Name{
+		Space: "urn:ebay:apis:eBLBaseComponents",
+		Local: c.CallName(),
+	}
+	c.RequesterCredentials = &RequesterCredentials{EBayAuthToken: creds.AuthToken}
+	return c
+}
+
+// ParseResponse retruns response data as EbayResponse object
+func (c GeteBayDetailsRequest) ParseResponse(r []byte) (EbayResponse, error) {
+	var xmlResponse GeteBayDetailsResponse
+	err := xml.Unmarshal(r, &xmlResponse)
+
+	return xmlResponse, err
+}
+
+// ResponseErrors returns errors
+func (r GeteBayDetailsResponse) ResponseErrors() EbayErrors {
+	return r.ebayResponse.Errors
+}
+
+// GeteBayDetailsResponse type
+type GeteBayDetailsResponse struct {
+	ebayResponse
+	EbayDetails
+}
+
+// EbayDetails holds the site reference data returned by GeteBayDetails
+type EbayDetails struct {
+	CountryDetails                 []CountryDetails                 `xml:"CountryDetails"`
+	CurrencyDetails                []CurrencyDetails                `xml:"CurrencyDetails"`
+	DispatchTimeMaxDetails         []DispatchTimeMaxDetails         `xml:"DispatchTimeMaxDetails"`
+	ExcludeShippingLocationDetails []ExcludeShippingLocationDetails `xml:"ExcludeShippingLocationDetails"`
+	ReturnPolicyDetails            *ReturnPolicyDetails             `xml:"ReturnPolicyDetails"`
+	ShippingCarrierDetails         []ShippingCarrierDetails         `xml:"ShippingCarrierDetails"`
+	ShippingLocationDetails        []ShippingLocationDetails        `xml:"ShippingLocationDetails"`
+	ShippingPackageDetails         []ShippingPackageDetails         `xml:"ShippingPackageDetails"`
+	ShippingServiceDetails         []ShippingServiceDetails         `xml:"ShippingServiceDetails"`
+	SiteDetails                    []SiteDetails                    `xml:"SiteDetails"`
+	TimeZoneDetails                []TimeZoneDetails                `xml:"TimeZoneDetails"`
+}
+
+// CountryDetails type
+type CountryDetails struct {
+	Country       string
+	Description   string
+	DetailVersion string
+	UpdateTime    *time.Time
+}
+
+// CurrencyDetails type
+type CurrencyDetails struct {
+	Currency      string
+	Description   string
+	DetailVersion string
+	UpdateTime    *time.Time
+}
+
+// DispatchTimeMaxDetails type - the handling times sellers can offer
+type DispatchTimeMaxDetails struct {
+	DispatchTimeMax  int
+	Description      string
+	ExtendedHandling bool
+	DetailVersion    string
+	UpdateTime       *time.Time
+}
+
+// ExcludeShippingLocationDetails type
+type ExcludeShippingLocationDetails struct {
+	Description   string
+	Location      string
+	Region        string
+	DetailVersion string
+	UpdateTime    *time.Time
+}
+
+// ReturnPolicyDetails type - the allowed ReturnPolicy option values
+type ReturnPolicyDetails struct {
+	Description        bool
+	Refund             []ReturnPolicyOption `xml:"Refund"`
+	ReturnsAccepted    []ReturnPolicyOption `xml:"ReturnsAccepted"`
+	ReturnsWithin      []ReturnPolicyOption `xml:"ReturnsWithin"`
+	ShippingCostPaidBy []ReturnPolicyOption `xml:"ShippingCostPaidBy"`
+	DetailVersion      string
+	UpdateTime         *time.Time
+}
+
+// ReturnPolicyOption type - Option holds the RefundOption, ReturnsWithinOption... value
+type ReturnPolicyOption struct {
+	Option      string
+	Description string
+}
+
+// UnmarshalXML reads the *Option element whatever its name
+func (o *ReturnPolicyOption) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var fields struct {
+		Inner []struct {
+			XMLName xml.Name
+			Value   string `xml:",chardata"`
+		} `xml:",any"`
+	}
+	if err := d.DecodeElement(&fields, &start); err != nil {
+		return err
+	}
+
+	for _, f := range fields.Inner {
+		if f.XMLName.Local == "Description" {
+			o.Description = f.Value
+		} else {
+			o.Option = f.Value
+		}
+	}
+	return nil
+}
+
+// ShippingCarrierDetails type - ShippingCarrier values are used as ShippingCarrierUsed
+type ShippingCarrierDetails struct {
+	ShippingCarrierID int
+	ShippingCarrier   string
+	Description       string
+	DetailVersion     string
+	UpdateTime        *time.Time
+}
+
+// ShippingLocationDetails type
+type ShippingLocationDetails struct {
+	ShippingLocation string
+	Description      string
+	DetailVersion    string
+	UpdateTime       *time.Time
+}
+
+// ShippingPackageDetails type
+type ShippingPackageDetails struct {
+	PackageID          int
+	ShippingPackage    string
+	Description        string
+	DefaultValue       bool
+	DimensionsRequired bool
+	DetailVersion      string
+	UpdateTime         *time.Time
+}
+
+// ShippingServiceDetails type
+type ShippingServiceDetails struct {
+	ShippingServiceID    int
+	ShippingService      string
+	Description          string
+	ShippingCarrier      []string `xml:"ShippingCarrier"`
+	ShippingCategory     string
+	ServiceType          []string `xml:"ServiceType"`
+	ExpeditedService     bool
+	InternationalService bool
+	ShippingTimeMin      int
+	ShippingTimeMax      int
+	ValidForSellingFlow  bool
+	DimensionsRequired   bool
+	WeightRequired       bool
+	DetailVersion        string
+	UpdateTime           *time.Time
+}
+
+// SiteDetails type
+type SiteDetails struct {
+	Site          string
+	SiteID        int
+	DetailVersion string
+	UpdateTime    *time.Time
+}
+
+// TimeZoneDetails type
+type TimeZoneDetails struct {
+	TimeZoneID              string
+	StandardLabel           string
+	StandardOffset          string
+	DaylightSavingsLabel    string
+	DaylightSavingsOffset   string
+	DaylightSavingsInEffect bool
+	DetailVersion           string
+	UpdateTime              *time.Time
+}
@@ -0,0 +1,298 @@
+package ebayapi
+
+import "time"
+
+//
+// Item mirrors the Trading API ItemType: https://developer.ebay.com/devzone/xml/docs/reference/ebay/types/ItemType.html
+// It is shared by GetItem, GetMyeBaySelling and the listing calls, so every field is omitempty and nested
+// types are pointers to keep unset sections out of requests.
+//
+
+// Item type
+type Item struct {
+	ApplicationData         string                 `xml:"ApplicationData,omitempty"`
+	AutoPay                 bool                   `xml:"AutoPay,omitempty"`
+	BestOfferDetails        *BestOfferDetails      `xml:"BestOfferDetails,omitempty"`
+	BuyItNowPrice           *Price                 `xml:"BuyItNowPrice,omitempty"`
+	CategoryMappingAllowed  bool                   `xml:"CategoryMappingAllowed,omitempty"`
+	ConditionDescription    string                 `xml:"ConditionDescription,omitempty"`
+	ConditionDisplayName    string                 `xml:"ConditionDisplayName,omitempty"`
+	ConditionID             int                    `xml:"ConditionID,omitempty"`
+	Country                 string                 `xml:"Country,omitempty"`
+	Currency                string                 `xml:"Currency,omitempty"`
+	Description             string                 `xml:"Description,omitempty"`
+	DispatchTimeMax         int                    `xml:"DispatchTimeMax,omitempty"`
+	HitCount                int64                  `xml:"HitCount,omitempty"`
+	HitCounter              string                 `xml:"HitCounter,omitempty"`
+	InventoryTrackingMethod string                 `xml:"InventoryTrackingMethod,omitempty"`
+	ItemCompatibilityCount  int                    `xml:"ItemCompatibilityCount,omitempty"`
+	ItemCompatibilityList   *ItemCompatibilityList `xml:"ItemCompatibilityList,omitempty"`
+	ItemID                  string                 `xml:"ItemID,omitempty"`
+	ItemSpecifics           *NameValueListArray    `xml:"ItemSpecifics,omitempty"`
+	ListingDetails          *ListingDetails        `xml:"ListingDetails,omitempty"`
+	ListingDuration         string                 `xml:"ListingDuration,omitempty"`
+	ListingType             string                 `xml:"ListingType,omitempty"`
+	Location                string                 `xml:"Location,omitempty"`
+	OutOfStockControl       bool                   `xml:"OutOfStockControl,omitempty"`
+	PaymentMethods          []string               `xml:"PaymentMethods,omitempty"`
+	PictureDetails          *PictureDetails        `xml:"PictureDetails,omitempty"`
+	PostalCode              string                 `xml:"PostalCode,omitempty"`
+	PrimaryCategory         *Category              `xml:"PrimaryCategory,omitempty"`
+	PrivateListing          bool                   `xml:"PrivateListing,omitempty"`
+	ProductListingDetails   *ProductListingDetails `xml:"ProductListingDetails,omitempty"`
+	Quantity                int                    `xml:"Quantity,omitempty"`
+	QuantityAvailable       int                    `xml:"QuantityAvailable,omitempty"`
+	ReturnPolicy            *ReturnPolicy          `xml:"ReturnPolicy,omitempty"`
+	SecondaryCategory       *Category              `xml:"SecondaryCategory,omitempty"`
+	SellerProfiles          *SellerProfiles        `xml:"SellerProfiles,omitempty"`
+	SellingStatus           *SellingStatus         `xml:"SellingStatus,omitempty"`
+	ShippingDetails         *ShippingDetails       `xml:"ShippingDetails,omitempty"`
+	ShipToLocations         []string               `xml:"ShipToLocations,omitempty"`
+	Site                    string                 `xml:"Site,omitempty"`
+	SKU                     string                 `xml:"SKU,omitempty"`
+	StartPrice              *Price                 `xml:"StartPrice,omitempty"`
+	Storefront              *Storefront            `xml:"Storefront,omitempty"`
+	SubTitle                string                 `xml:"SubTitle,omitempty"`
+	TimeLeft                string                 `xml:"TimeLeft,omitempty"`
+	Title                   string                 `xml:"Title,omitempty"`
+	UUID                    string                 `xml:"UUID,omitempty"`
+	Variations              *Variations            `xml:"Variations,omitempty"`
+	WatchCount              int64                  `xml:"WatchCount,omitempty"`
+}
+
+// Price struct
+type Price struct {
+	Amount     float64 `xml:",chardata"`
+	CurrencyID string  `xml:"currencyID,attr,omitempty"`
+}
+
+// BestOfferDetails type
+type BestOfferDetails struct {
+	BestOfferCount   int  `xml:"BestOfferCount,omitempty"`
+	BestOfferEnabled bool `xml:"BestOfferEnabled,omitempty"`
+}
+
+// Category type - listings only carry CategoryID and CategoryName, the remaining fields are returned by GetCategories
+type Category struct {
+	CategoryID       string   `xml:"CategoryID,omitempty"`
+	CategoryName     string   `xml:"CategoryName,omitempty"`
+	CategoryLevel    int      `xml:"CategoryLevel,omitempty"`
+	CategoryParentID []string `xml:"CategoryParentID,omitempty"`
+	LeafCategory     bool     `xml:"LeafCategory,omitempty"`
+	AutoPayEnabled   bool     `xml:"AutoPayEnabled,omitempty"`
+	BestOfferEnabled bool     `xml:"BestOfferEnabled,omitempty"`
+	Expired          bool     `xml:"Expired,omitempty"`
+	LSD              bool     `xml:"LSD,omitempty"`
+	Virtual          bool     `xml:"Virtual,omitempty"`
+}
+
+// NameValueListArray type - used for ItemSpecifics and VariationSpecifics
+type NameValueListArray struct {
+	NameValueList []NameValueList `xml:"NameValueList,omitempty"`
+}
+
+// NameValueList type
+type NameValueList struct {
+	Name   string   `xml:"Name,omitempty"`
+	Value  []string `xml:"Value,omitempty"`
+	Source string   `xml:"Source,omitempty"`
+}
+
+// ListingDetails type - output only
+type ListingDetails struct {
+	StartTime              *time.Time `xml:"StartTime,omitempty"`
+	EndTime                *time.Time `xml:"EndTime,omitempty"`
+	ViewItemURL            string     `xml:"ViewItemURL,omitempty"`
+	RelistedItemID         string     `xml:"RelistedItemID,omitempty"`
+	ConvertedStartPrice    *Price     `xml:"ConvertedStartPrice,omitempty"`
+	ConvertedBuyItNowPrice *Price     `xml:"ConvertedBuyItNowPrice,omitempty"`
+	HasUnansweredQuestions bool       `xml:"HasUnansweredQuestions,omitempty"`
+	EndingReason           string     `xml:"EndingReason,omitempty"`
+}
+
+// PictureDetails type
+type PictureDetails struct {
+	GalleryType        string   `xml:"GalleryType,omitempty"`
+	GalleryURL         string   `xml:"GalleryURL,omitempty"`
+	PhotoDisplay       string   `xml:"PhotoDisplay,omitempty"`
+	PictureURL         []string `xml:"PictureURL,omitempty"`
+	PictureSource      string   `xml:"PictureSource,omitempty"`
+	ExternalPictureURL []string `xml:"ExternalPictureURL,omitempty"`
+}
+
+// ProductListingDetails type
+type ProductListingDetails struct {
+	BrandMPN                  *BrandMPN `xml:"BrandMPN,omitempty"`
+	EAN                       string    `xml:"EAN,omitempty"`
+	ISBN                      string    `xml:"ISBN,omitempty"`
+	UPC                       string    `xml:"UPC,omitempty"`
+	IncludeeBayProductDetails bool      `xml:"IncludeeBayProductDetails,omitempty"`
+}
+
+// BrandMPN type
+type BrandMPN struct {
+	Brand string `xml:"Brand,omitempty"`
+	MPN   string `xml:"MPN,omitempty"`
+}
+
+// ReturnPolicy type
+type ReturnPolicy struct {
+	Description                           string `xml:"Description,omitempty"`
+	RefundOption                          string `xml:"RefundOption,omitempty"`
+	ReturnsAcceptedOption                 string `xml:"ReturnsAcceptedOption,omitempty"`
+	ReturnsWithinOption                   string `xml:"ReturnsWithinOption,omitempty"`
+	ShippingCostPaidByOption              string `xml:"ShippingCostPaidByOption,omitempty"`
+	InternationalRefundOption             string `xml:"InternationalRefundOption,omitempty"`
+	InternationalReturnsAcceptedOption    string `xml:"InternationalReturnsAcceptedOption,omitempty"`
+	InternationalReturnsWithinOption      string `xml:"InternationalReturnsWithinOption,omitempty"`
+	InternationalShippingCostPaidByOption string `xml:"InternationalShippingCostPaidByOption,omitempty"`
+}
+
+// SellerProfiles type - business policies applied to the listing
+type SellerProfiles struct {
+	SellerPaymentProfile  *SellerPaymentProfile  `xml:"SellerPaymentProfile,omitempty"`
+	SellerReturnProfile   *SellerReturnProfile   `xml:"SellerReturnProfile,omitempty"`
+	SellerShippingProfile *SellerShippingProfile `xml:"SellerShippingProfile,omitempty"`
+}
+
+// SellerPaymentProfile type
+type SellerPaymentProfile struct {
+	PaymentProfileID   int64  `xml:"PaymentProfileID,omitempty"`
+	PaymentProfileName string `xml:"PaymentProfileName,omitempty"`
+}
+
+// SellerReturnProfile type
+type SellerReturnProfile struct {
+	ReturnProfileID   int64  `xml:"ReturnProfileID,omitempty"`
+	ReturnProfileName string `xml:"ReturnProfileName,omitempty"`
+}
+
+// SellerShippingProfile type
+type SellerShippingProfile struct {
+	ShippingProfileID   int64  `xml:"ShippingProfileID,omitempty"`
+	ShippingProfileName string `xml:"ShippingProfileName,omitempty"`
+}
+
+// SellingStatus type - output only, Item.SellingStatus is nil unless ebay returned it
+type SellingStatus struct {
+	BidCount              int    `xml:"BidCount,omitempty"`
+	ConvertedCurrentPrice *Price `xml:"ConvertedCurrentPrice,omitempty"`
+	CurrentPrice          *Price `xml:"CurrentPrice,omitempty"`
+	ListingStatus         string `xml:"ListingStatus,omitempty"`
+	QuantitySold          int    `xml:"QuantitySold,omitempty"`
+}
+
+// ShippingDetails type
+type ShippingDetails struct {
+	ExcludeShipToLocation              []string                              `xml:"ExcludeShipToLocation,omitempty"`
+	GlobalShipping                     bool                                  `xml:"GlobalShipping,omitempty"`
+	InternationalShippingServiceOption []*InternationalShippingServiceOption `xml:"InternationalShippingServiceOption,omitempty"`
+	ShippingServiceOptions             []*ShippingServiceOptions             `xml:"ShippingServiceOptions,omitempty"`
+	ShippingType                       string                                `xml:"ShippingType,omitempty"`
+	TaxTable                           *TaxTable                             `xml:"TaxTable,omitempty"`
+}
+
+// TaxTable type - returned with IncludeTaxTable
+type TaxTable struct {
+	TaxJurisdiction []*TaxJurisdiction `xml:"TaxJurisdiction,omitempty"`
+}
+
+// TaxJurisdiction type
+type TaxJurisdiction struct {
+	JurisdictionID        string  `xml:"JurisdictionID,omitempty"`
+	SalesTaxPercent       float64 `xml:"SalesTaxPercent,omitempty"`
+	ShippingIncludedInTax bool    `xml:"ShippingIncludedInTax,omitempty"`
+}
+
+// ItemCompatibilityList type - parts compatibility, returned with IncludeItemCompatibilityList
+type ItemCompatibilityList struct {
+	Compatibility []*ItemCompatibility `xml:"Compatibility,omitempty"`
+	ReplaceAll    bool                 `xml:"ReplaceAll,omitempty"`
+}
+
+// ItemCompatibility type
+type ItemCompatibility struct {
+	CompatibilityNotes string          `xml:"CompatibilityNotes,omitempty"`
+	NameValueList      []NameValueList `xml:"NameValueList,omitempty"`
+	Delete             bool            `xml:"Delete,omitempty"`
+}
+
+// ShippingServiceOptions type - domestic shipping service
+type ShippingServiceOptions struct {
+	FreeShipping                  bool   `xml:"FreeShipping,omitempty"`
+	ShippingService               string `xml:"ShippingService,omitempty"`
+	ShippingServiceAdditionalCost *Price `xml:"ShippingServiceAdditionalCost,omitempty"`
+	ShippingServiceCost           *Price `xml:"ShippingServiceCost,omitempty"`
+	ShippingServicePriority       int    `xml:"ShippingServicePriority,omitempty"`
+	ExpeditedService              bool   `xml:"ExpeditedService,omitempty"`
+	ShippingTimeMin               int    `xml:"ShippingTimeMin,omitempty"`
+	ShippingTimeMax               int    `xml:"ShippingTimeMax,omitempty"`
+}
+
+// InternationalShippingServiceOption type
+type InternationalShippingServiceOption struct {
+	ShippingService               string   `xml:"ShippingService,omitempty"`
+	ShippingServiceAdditionalCost *Price   `xml:"ShippingServiceAdditionalCost,omitempty"`
+	ShippingServiceCost           *Price   `xml:"ShippingServiceCost,omitempty"`
+	ShippingServicePriority       int      `xml:"ShippingServicePriority,omitempty"`
+	ShipToLocation                []string `xml:"ShipToLocation,omitempty"`
+}
+
+// Storefront type - ebay store categories
+type Storefront struct {
+	StoreCategoryID  int64 `xml:"StoreCategoryID,omitempty"`
+	StoreCategory2ID int64 `xml:"StoreCategory2ID,omitempty"`
+}
+
+// ForRevision returns a copy of an item fetched with GetItem prepared for ReviseFixedPriceItem. Output only
+// fields are removed, and Quantity is reduced by QuantitySold as GetItem reports the originally listed
+// quantity while revise calls set the quantity available
+func (i Item) ForRevision() *Item {
+	return i.withoutOutputFields(true)
+}
+
+// ForRelist returns a copy of an ended item fetched with GetItem prepared for the relist calls. Output only
+// fields are removed and the originally listed quantities are kept, a relisted item starts over with them
+func (i Item) ForRelist() *Item {
+	return i.withoutOutputFields(false)
+}
+
+// withoutOutputFields copies the item without output only fields, with available set quantities are reduced
+// by the quantities sold
+func (i Item) withoutOutputFields(available bool) *Item {
+	if available && i.SellingStatus != nil {
+		i.Quantity -= i.SellingStatus.QuantitySold
+	}
+	i.SellingStatus = nil
+	i.ListingDetails = nil
+	i.ConditionDisplayName = ""
+	i.HitCount = 0
+	i.ItemCompatibilityCount = 0
+	i.QuantityAvailable = 0
+	i.TimeLeft = ""
+	i.WatchCount = 0
+	if i.BestOfferDetails != nil {
+		bo := *i.BestOfferDetails
+		bo.BestOfferCount = 0
+		i.BestOfferDetails = &bo
+	}
+
+	if i.Variations != nil {
+		vars := *i.Variations
+		vars.Variation = make([]*Variation, len(i.Variations.Variation))
+		for n, v := range i.Variations.Variation {
+			variation := *v
+			if available {
+				variation.Quantity = v.Available()
+			}
+			variation.SellingStatus = nil
+			variation.VariationTitle = ""
+			variation.VariationViewItemURL = ""
+			variation.WatchCount = 0
+			vars.Variation[n] = &variation
+		}
+		i.Variations = &vars
+	}
+
+	return &i
+}
@@ -0,0 +1,107 @@
+package ebayapi
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+// MaxFeedbackCommentLength is the longest feedback comment ebay accepts
+const MaxFeedbackCommentLength = 80
+
+// CommentType values of feedback
+const (
+	CommentTypePositive = "Positive"
+	CommentTypeNeutral  = "Neutral"
+	CommentTypeNegative = "Negative"
+)
+
+// FeedbackInfo type - feedback left with CompleteSale, also returned as FeedbackLeft/FeedbackReceived
+type FeedbackInfo struct {
+	CommentText string `xml:"CommentText,omitempty"`
+	CommentType string `xml:"CommentType,omitempty"`
+	TargetUser  string `xml:"TargetUser,omitempty"`
+}
+
+// Validate checks the feedback is positive, as sellers can't leave buyers neutral or negative feedback, and
+// that the comment fits
+func (f FeedbackInfo) Validate() error {
+	return validateFeedback(f.TargetUser, f.CommentType, f.CommentText)
+}
+
+func validateFeedback(targetUser, commentType, commentText string) error {
+	if targetUser == "" {
+		return errors.New("ERROR[Feedback]: TargetUser value missing")
+	}
+	if commentType != CommentTypePositive {
+		return fmt.Errorf("ERROR[Feedback]: sellers can only leave Positive feedback, got %q", commentType)
+	}
+	if commentText == "" {
+		return errors.New("ERROR[Feedback]: CommentText value missing")
+	}
+	if n := utf8.RuneCountInString(commentText); n > MaxFeedbackCommentLength {
+		return fmt.Errorf("ERROR[Feedback]: comment is %d characters, at most %d allowed", n, MaxFeedbackCommentLength)
+	}
+
+	return nil
+}
+
+// LeaveFeedbackRequest type - identify the line item with ItemID and TransactionID or OrderLineItemID
+type LeaveFeedbackRequest struct {
+	XMLName              xml.Name
+	RequesterCredentials *RequesterCredentials
+	ItemID               string `xml:"ItemID,omitempty"`
+	TransactionID        string `xml:"TransactionID,omitempty"`
+	OrderLineItemID      string `xml:"OrderLineItemID,omitempty"`
+	TargetUser           string `xml:"TargetUser"`
+	CommentText          string `xml:"CommentText"`
+	CommentType          string `xml:"CommentType"`
+	ErrorLanguage        string `xml:"ErrorLanguage,omitempty"`
+	MessageID            string `xml:"MessageID,omitempty"`
+	Version              string `xml:"Version,omitempty"`
+	WarningLevel         string `xml:"WarningLevel,omitempty"`
+}
+
+// Validate checks the line item is identified and the feedback can be left
+func (c LeaveFeedbackRequest) Validate() error {
+	if c.OrderLineItemID == "" && (c.ItemID == "" || c.TransactionID == "") {
+		return errors.New("ERROR[LeaveFeedback]: OrderLineItemID or ItemID and TransactionID value missing")
+	}
+
+	return validateFeedback(c.TargetUser, c.CommentType, c.CommentText)
+}
+
+// CallName returns name of call
+func (c LeaveFeedbackRequest) CallName() string {
+	return "LeaveFeedback"
+}
+
+// Body ataches credential and returns XML body
+func (c LeaveFeedbackRequest) Body(creds *Credentials) interface{} {
+	c.XMLName = xml.Name{
+		Space: "urn:ebay:apis:eBLBaseComponents",
+		Local: c.CallName(),
+	}
+	c.RequesterCredentials = &RequesterCredentials{EBayAuthToken: creds.AuthToken}
+	return c
+}
+
+// ParseResponse retruns response data as EbayResponse object
+func (c LeaveFeedbackRequest) ParseResponse(r []byte) (EbayResponse, error) {
+	var xmlResponse LeaveFeedbackResponse
+	err := xml.Unmarshal(r, &xmlResponse)
+
+	return xmlResponse, err
+}
+
+// ResponseErrors returns errors
+func (r LeaveFeedbackResponse) ResponseErrors() EbayErrors {
+	return r.ebayResponse.Errors
+}
+
+// LeaveFeedbackResponse type
+type LeaveFeedbackResponse struct {
+	ebayResponse
+	FeedbackID string
+}
@@ -0,0 +1,267 @@
+package ebayapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// MaxItemSpecificLength is the longest item specific name or value ebay accepts
+const MaxItemSpecificLength = 65
+
+// ListingIssue is a problem found by validating an Item against its category rules
+type ListingIssue struct {
+	// Field is the Item field or item specific name the issue refers to
+	Field   string
+	Message string
+	// Warning issues don't prevent listing, e.g. missing recommended item specifics
+	Warning bool
+}
+
+func (i ListingIssue) String() string {
+	return i.Field + ": " + i.Message
+}
+
+// ListingIssues holds all issues of an Item
+type ListingIssues []ListingIssue
+
+func (issues ListingIssues) Error() string {
+	var msgs []string
+	for _, i := range issues {
+		msgs = append(msgs, i.String())
+	}
+
+	return "ERROR[ValidateItem]: " + strings.Join(msgs, ", ")
+}
+
+// Errors returns the issues ebay would reject the listing for
+func (issues ListingIssues) Errors() ListingIssues {
+	var errs ListingIssues
+	for _, i := range issues {
+		if !i.Warning {
+			errs = append(errs, i)
+		}
+	}
+
+	return errs
+}
+
+// Warnings returns the issues which don't prevent listing
+func (issues ListingIssues) Warnings() ListingIssues {
+	var warnings ListingIssues
+	for _, i := range issues {
+		if i.Warning {
+			warnings = append(warnings, i)
+		}
+	}
+
+	return warnings
+}
+
+// Err returns the blocking issues as error, or nil when the item can be submitted
+func (issues ListingIssues) Err() error {
+	if errs := issues.Errors(); len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// ListingRules are the constraints of a category an Item is validated against
+type ListingRules struct {
+	CategoryID string
+	Specifics  Recommendations
+	Features   CategoryFeature
+	// Durations holds the allowed ListingDuration values by ListingType
+	Durations map[string][]string
+}
+
+// GetListingRules gets the item specifics and features of a category
+func (api *TradingAPI) GetListingRules(ctx context.Context, categoryID string) (*ListingRules, error) {
+	specifics, err := api.GetCategorySpecifics(ctx, &GetCategorySpecificsRequest{CategoryID: []string{categoryID}})
+	if err != nil {
+		return nil, err
+	}
+
+	features, err := api.GetCategoryFeatures(ctx, &GetCategoryFeaturesRequest{
+		CategoryID:             categoryID,
+		AllFeaturesForCategory: true,
+		ViewAllNodes:           true,
+		DetailLevel:            []string{DetailLevelReturnAll},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rules := &ListingRules{
+		CategoryID: categoryID,
+		Features:   features.Features(categoryID),
+		Durations:  map[string][]string{},
+	}
+	if recs := specifics.ForCategory(categoryID); recs != nil {
+		rules.Specifics = *recs
+	}
+	for _, ref := range rules.Features.ListingDuration {
+		rules.Durations[ref.Type] = features.Durations(rules.Features, ref.Type)
+	}
+
+	return rules, nil
+}
+
+// Validate checks item specifics, condition, variations and listing duration of the item. Items without
+// ListingType are validated as FixedPriceItem
+func (r *ListingRules) Validate(item *Item) ListingIssues {
+	var issues ListingIssues
+	add := func(field string, warning bool, format string, args ...interface{}) {
+		issues = append(issues, ListingIssue{Field: field, Message: fmt.Sprintf(format, args...), Warning: warning})
+	}
+
+	if item.PrimaryCategory != nil && item.PrimaryCategory.CategoryID != "" && item.PrimaryCategory.CategoryID != r.CategoryID {
+		add("PrimaryCategory", false, "rules are for category %s, item is in %s", r.CategoryID, item.PrimaryCategory.CategoryID)
+	}
+
+	switch {
+	case item.ConditionID == 0 && r.Features.ConditionEnabled == ConditionRequired:
+		add("ConditionID", false, "condition is required in this category")
+	case item.ConditionID != 0 && r.Features.ConditionEnabled == ConditionDisabled:
+		add("ConditionID", false, "conditions are not supported in this category")
+	case item.ConditionID != 0 && !r.Features.AllowsCondition(item.ConditionID):
+		add("ConditionID", false, "condition %d is not allowed in this category", item.ConditionID)
+	}
+
+	varied := map[string]bool{}
+	if item.Variations != nil && len(item.Variations.Variation) > 0 {
+		if r.Features.VariationsEnabled != nil && !*r.Features.VariationsEnabled {
+			add("Variations", false, "variations are not supported in this category")
+		}
+		for _, v := range item.Variations.Variation {
+			if v.VariationSpecifics == nil {
+				continue
+			}
+			for _, nv := range v.VariationSpecifics.NameValueList {
+				varied[strings.ToLower(nv.Name)] = true
+			}
+		}
+	}
+
+	listingType := item.ListingType
+	if listingType == "" {
+		listingType = "FixedPriceItem"
+	}
+	if durations, ok := r.Durations[listingType]; ok && item.ListingDuration != "" && !containsString(durations, item.ListingDuration) {
+		add("ListingDuration", false, "%s is not allowed for %s, use one of %s", item.ListingDuration, listingType, strings.Join(durations, ", "))
+	}
+
+	specifics := map[string][]string{}
+	if item.ItemSpecifics != nil {
+		if len(item.ItemSpecifics.NameValueList) > 0 && r.Features.ItemSpecificsEnabled == "Disabled" {
+			add("ItemSpecifics", false, "item specifics are not supported in this category")
+		}
+		for _, nv := range item.ItemSpecifics.NameValueList {
+			specifics[strings.ToLower(nv.Name)] = nv.Value
+			if len(nv.Name) > MaxItemSpecificLength {
+				add(nv.Name, false, "name longer than %d characters", MaxItemSpecificLength)
+			}
+			for _, v := range nv.Value {
+				if len(v) > MaxItemSpecificLength {
+					add(nv.Name, false, "value %q longer than %d characters", v, MaxItemSpecificLength)
+				}
+			}
+		}
+	}
+
+	for _, rec := range r.Specifics.NameRecommendation {
+		name := strings.ToLower(rec.Name)
+		values, set := specifics[name]
+
+		if varied[name] {
+			if rec.ValidationRules.VariationSpecifics == "Disabled" {
+				add(rec.Name, false, "can't be used to vary variations")
+			}
+			continue
+		}
+
+		if !set || len(values) == 0 {
+			if rec.Required() {
+				add(rec.Name, false, "required item specific missing")
+			} else if rec.Recommended() {
+				add(rec.Name, true, "recommended item specific missing")
+			}
+			continue
+		}
+
+		if rec.ValidationRules.MaxValues > 0 && len(values) > rec.ValidationRules.MaxValues {
+			add(rec.Name, false, "%d values set, at most %d allowed", len(values), rec.ValidationRules.MaxValues)
+		}
+		for _, v := range values {
+			if !rec.Allows(v) {
+				add(rec.Name, false, "value %q is not one of the allowed values", v)
+			}
+		}
+	}
+
+	return issues
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ListingValidator validates items against the rules of their primary category, fetching the rules of each
+// category once
+type ListingValidator struct {
+	api *TradingAPI
+
+	mu    sync.Mutex
+	rules map[string]*ListingRules
+}
+
+// NewListingValidator instantiates a validator with an empty rules cache
+func NewListingValidator(api *TradingAPI) *ListingValidator {
+	return &ListingValidator{
+		api:   api,
+		rules: map[string]*ListingRules{},
+	}
+}
+
+// Rules returns the cached rules of the category, fetching them on first use
+func (v *ListingValidator) Rules(ctx context.Context, categoryID string) (*ListingRules, error) {
+	v.mu.Lock()
+	rules, ok := v.rules[categoryID]
+	v.mu.Unlock()
+	if ok {
+		return rules, nil
+	}
+
+	rules, err := v.api.GetListingRules(ctx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	v.mu.Lock()
+	v.rules[categoryID] = rules
+	v.mu.Unlock()
+	return rules, nil
+}
+
+// Validate returns the issues of the item, the error is only set when the category rules can't be fetched
+func (v *ListingValidator) Validate(ctx context.Context, item *Item) (ListingIssues, error) {
+	if item.PrimaryCategory == nil || item.PrimaryCategory.CategoryID == "" {
+		return nil, errors.New("ERROR[ValidateItem]: PrimaryCategory.CategoryID value missing")
+	}
+
+	rules, err := v.Rules(ctx, item.PrimaryCategory.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return rules.Validate(item), nil
+}
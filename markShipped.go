@@ -0,0 +1,362 @@
+package ebayapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Package is a parcel of an order shipped with a tracking number
+type Package struct {
+	Carrier        string
+	TrackingNumber string
+	ShippedTime    *time.Time
+	// LineItems are the line items in the package and their quantities, the whole order when empty
+	LineItems []*LineItem
+}
+
+// key identifies the package by carrier and tracking number
+func (p Package) key() string {
+	return strings.ToUpper(p.Carrier) + "|" + p.TrackingNumber
+}
+
+// lineItemKey identifies a line item of an order
+func lineItemKey(itemID, transactionID string) string {
+	return itemID + "|" + transactionID
+}
+
+// DefaultShippingCarriers are the ShippingCarrierUsed codes accepted when TradingAPI.Details has no
+// ShippingCarrierDetails, load them with SyncEbayDetails to validate against the carriers of the site
+var DefaultShippingCarriers = []string{
+	"Aramex", "AustraliaPost", "CanadaPost", "Canpar", "Chronopost", "Colissimo", "Correos", "DeutschePost",
+	"DHL", "DHLGlobalMail", "DPD", "FedEx", "FedExSmartPost", "GLS", "Hermes", "iLoxx", "LaserShip", "Nacex",
+	"OnTrac", "ParcelForce", "PostNL", "Purolator", "RoyalMail", "Seur", "StarTrack", "TNT", "UPS",
+	"UPSMailInnovations", "USPS", "Yodel", "Other",
+}
+
+// MarkShippedResult reports what MarkShipped uploaded
+type MarkShippedResult struct {
+	Order *Order
+	// Uploaded are the packages ebay accepted, limited to the line items not uploaded before
+	Uploaded []Package
+	// Duplicates are the packages whose tracking number was uploaded before for the same line items, a package
+	// uploaded before for some of its line items only is listed in both with the line items split accordingly
+	Duplicates []Package
+}
+
+// normalizeTrackingNumber drops the spaces and dashes ebay rejects in tracking numbers
+func normalizeTrackingNumber(tracking string) string {
+	return strings.Map(func(r rune) rune {
+		if r == ' ' || r == '-' || r == '\t' {
+			return -1
+		}
+		return r
+	}, strings.ToUpper(tracking))
+}
+
+// carrier returns the canonical ShippingCarrierUsed code, checked against Details or DefaultShippingCarriers
+func (api *TradingAPI) carrier(carrier string) (string, bool) {
+	if api.Details != nil && len(api.Details.ShippingCarrierDetails) > 0 {
+		details, ok := api.Details.ShippingCarrier(carrier)
+		if !ok {
+			return "", false
+		}
+		return details.ShippingCarrier, true
+	}
+
+	for _, c := range DefaultShippingCarriers {
+		if strings.EqualFold(c, carrier) {
+			return c, true
+		}
+	}
+
+	return "", false
+}
+
+// validatePackages normalizes the packages, checks their carriers and merges packages sharing a tracking
+// number, e.g. one parcel listed once per line item it holds
+func (api *TradingAPI) validatePackages(packages []Package) ([]Package, error) {
+	var valid []Package
+	merged := map[string]int{}
+	perLineItem := -1
+	for i, p := range packages {
+		p.Carrier = strings.TrimSpace(p.Carrier)
+		p.TrackingNumber = normalizeTrackingNumber(p.TrackingNumber)
+		if p.Carrier == "" || p.TrackingNumber == "" {
+			return nil, fmt.Errorf("ERROR[MarkShipped]: package %d needs Carrier and TrackingNumber", i)
+		}
+
+		carrier, ok := api.carrier(p.Carrier)
+		if !ok {
+			return nil, fmt.Errorf("ERROR[MarkShipped]: unknown carrier %s", p.Carrier)
+		}
+		p.Carrier = carrier
+
+		hasLineItems := 0
+		if len(p.LineItems) > 0 {
+			hasLineItems = 1
+		}
+		if perLineItem >= 0 && perLineItem != hasLineItems {
+			return nil, errors.New("ERROR[MarkShipped]: either all or no packages must list their LineItems")
+		}
+		perLineItem = hasLineItems
+		for _, li := range p.LineItems {
+			if li == nil || li.ItemID == "" || li.TransactionID == "" {
+				return nil, errors.New("ERROR[MarkShipped]: LineItem needs ItemID and TransactionID")
+			}
+		}
+
+		j, ok := merged[p.key()]
+		if !ok {
+			merged[p.key()] = len(valid)
+			p.LineItems = append([]*LineItem(nil), p.LineItems...)
+			valid = append(valid, p)
+			continue
+		}
+
+		same := &valid[j]
+		for _, li := range p.LineItems {
+			if !containsLineItem(same.LineItems, li) {
+				same.LineItems = append(same.LineItems, li)
+			}
+		}
+		if p.ShippedTime != nil && (same.ShippedTime == nil || p.ShippedTime.Before(*same.ShippedTime)) {
+			same.ShippedTime = p.ShippedTime
+		}
+	}
+
+	return valid, nil
+}
+
+func containsLineItem(lineItems []*LineItem, li *LineItem) bool {
+	for _, other := range lineItems {
+		if other.ItemID == li.ItemID && other.TransactionID == li.TransactionID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MarkShipped marks an order as shipped and uploads the tracking numbers of its packages. Packages listing
+// their LineItems are uploaded per line item, which allows splitting an order across several parcels, and
+// packages sharing a tracking number are merged. A tracking number is skipped for the line items it was
+// already uploaded for, and ebay rejecting it as a duplicate reports it in Duplicates instead of failing, so
+// calling MarkShipped again with the same packages is safe. When a split shipment fails part way the result
+// still lists the line items uploaded before the failure
+func (api *TradingAPI) MarkShipped(ctx context.Context, orderID string, packages []Package) (*MarkShippedResult, error) {
+	if orderID == "" {
+		return nil, errors.New("ERROR[MarkShipped]: OrderID value missing")
+	}
+	packages, err := api.validatePackages(packages)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := api.GetOrdersPage(ctx, &GetOrdersRequest{
+		OrderIDArray: &OrderIDArray{OrderIDs: []string{orderID}},
+		DetailLevel:  []string{DetailLevelReturnAll},
+		OutputSelector: NewOutputSelector(
+			SelectOrderID,
+			SelectOrderStatus,
+			SelectOrderShippedTime,
+			SelectOrderShipmentTracking,
+			SelectTransactionID,
+			SelectTransactionItemID,
+			SelectTransactionQuantity,
+			SelectTransaction+".ShippingDetails.ShipmentTrackingDetails",
+		),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.OrderArray.Orders) == 0 {
+		return nil, errors.New("ERROR[MarkShipped]: order not found " + orderID)
+	}
+	result := &MarkShippedResult{Order: &resp.OrderArray.Orders[0]}
+
+	// Tracking numbers uploaded for the whole order, and per line item
+	orderUploaded := map[string]bool{}
+	for _, t := range result.Order.ShippingDetails.ShipmentTrackingDetails {
+		orderUploaded[Package{Carrier: t.ShippingCarrierUsed, TrackingNumber: normalizeTrackingNumber(t.ShipmentTrackingNumber)}.key()] = true
+	}
+	uploaded := map[string]map[string]bool{}
+	transactions := map[string]bool{}
+	for _, tr := range result.Order.TransactionArray.Transaction {
+		transactions[lineItemKey(tr.Item.ItemID, tr.TransactionID)] = true
+		for _, t := range tr.ShippingDetails.ShipmentTrackingDetails {
+			key := Package{Carrier: t.ShippingCarrierUsed, TrackingNumber: normalizeTrackingNumber(t.ShipmentTrackingNumber)}.key()
+			if uploaded[key] == nil {
+				uploaded[key] = map[string]bool{}
+			}
+			uploaded[key][lineItemKey(tr.Item.ItemID, tr.TransactionID)] = true
+		}
+	}
+
+	var pending []Package
+	for _, p := range packages {
+		if len(p.LineItems) == 0 {
+			// A whole order package was uploaded before when ebay lists it for the order or every line item
+			done := orderUploaded[p.key()] || len(transactions) > 0 && len(uploaded[p.key()]) == len(transactions)
+			if done {
+				result.Duplicates = append(result.Duplicates, p)
+			} else {
+				pending = append(pending, p)
+			}
+			continue
+		}
+
+		// Tracking listed for the order but none of its line items was uploaded for the whole order
+		wholeOrder := orderUploaded[p.key()] && len(uploaded[p.key()]) == 0
+
+		var fresh, seen []*LineItem
+		for _, li := range p.LineItems {
+			if !transactions[lineItemKey(li.ItemID, li.TransactionID)] {
+				return nil, fmt.Errorf("ERROR[MarkShipped]: line item %s/%s is not part of order %s", li.ItemID, li.TransactionID, orderID)
+			}
+			if wholeOrder || uploaded[p.key()][lineItemKey(li.ItemID, li.TransactionID)] {
+				seen = append(seen, li)
+			} else {
+				fresh = append(fresh, li)
+			}
+		}
+		if len(seen) > 0 {
+			dup := p
+			dup.LineItems = seen
+			result.Duplicates = append(result.Duplicates, dup)
+		}
+		if len(fresh) > 0 {
+			p.LineItems = fresh
+			pending = append(pending, p)
+		}
+	}
+
+	if len(pending) == 0 && result.Order.Shipped() {
+		return result, nil
+	}
+	if len(pending) == 0 || len(pending[0].LineItems) == 0 {
+		req := &CompleteSaleRequest{OrderID: orderID, Shipped: Bool(true)}
+		if len(pending) > 0 {
+			req.Shipment = shipmentOf(pending, nil)
+		}
+		if _, err := api.CompleteSale(ctx, req); err != nil {
+			dups, ok := duplicatePackages(err, pending)
+			if !ok {
+				return result, err
+			}
+			result.Duplicates = append(result.Duplicates, dups...)
+			return result, nil
+		}
+		result.Uploaded = pending
+		return result, nil
+	}
+
+	// Split shipment - one CompleteSale per line item with the packages containing it
+	var lineItems []*LineItem
+	byLineItem := map[string][]Package{}
+	for _, p := range pending {
+		for _, li := range p.LineItems {
+			key := lineItemKey(li.ItemID, li.TransactionID)
+			if _, ok := byLineItem[key]; !ok {
+				lineItems = append(lineItems, li)
+			}
+			byLineItem[key] = append(byLineItem[key], p)
+		}
+	}
+
+	// Line items are recorded as soon as their call succeeds, so a failure reports what was uploaded before it
+	for _, li := range lineItems {
+		packages := byLineItem[lineItemKey(li.ItemID, li.TransactionID)]
+		req := &CompleteSaleRequest{
+			ItemID:        li.ItemID,
+			TransactionID: li.TransactionID,
+			Shipped:       Bool(true),
+			Shipment:      shipmentOf(packages, li),
+		}
+		if _, err := api.CompleteSale(ctx, req); err != nil {
+			dups, ok := duplicatePackages(err, packages)
+			if !ok {
+				return result, err
+			}
+			for _, p := range dups {
+				result.Duplicates = addLineItem(result.Duplicates, p, li)
+			}
+			continue
+		}
+		for _, p := range packages {
+			result.Uploaded = addLineItem(result.Uploaded, p, li)
+		}
+	}
+
+	return result, nil
+}
+
+// addLineItem records the line item under the package with the same key, adding the package when missing
+func addLineItem(packages []Package, p Package, li *LineItem) []Package {
+	for i := range packages {
+		if packages[i].key() == p.key() {
+			if !containsLineItem(packages[i].LineItems, li) {
+				packages[i].LineItems = append(packages[i].LineItems, li)
+			}
+			return packages
+		}
+	}
+	p.LineItems = []*LineItem{li}
+
+	return append(packages, p)
+}
+
+// duplicatePackages returns the packages of a failed CompleteSale when ebay rejected them as uploaded before.
+// With several packages in the call every one must be named by an error, otherwise ok is false
+func duplicatePackages(err error, packages []Package) ([]Package, bool) {
+	ebayErrs, ok := err.(EbayErrors)
+	if !ok || !ebayErrs.DuplicateTracking() || len(packages) == 0 {
+		return nil, false
+	}
+	if len(packages) == 1 {
+		return packages, true
+	}
+
+	var named []string
+	for _, e := range ebayErrs.Errors() {
+		named = append(named, normalizeTrackingNumber(e.LongMessage))
+		for _, param := range e.ErrorParameters {
+			named = append(named, normalizeTrackingNumber(param.Value))
+		}
+	}
+	text := strings.Join(named, " ")
+	for _, p := range packages {
+		if !strings.Contains(text, p.TrackingNumber) {
+			return nil, false
+		}
+	}
+
+	return packages, true
+}
+
+// shipmentOf builds the tracking details of the packages, limited to the line item when not nil
+func shipmentOf(packages []Package, lineItem *LineItem) *Shipment {
+	shipment := &Shipment{}
+	for _, p := range packages {
+		details := &ShipmentTrackingDetails{
+			ShipmentTrackingNumber: p.TrackingNumber,
+			ShippingCarrierUsed:    p.Carrier,
+		}
+		if lineItem != nil {
+			for _, li := range p.LineItems {
+				if li.ItemID == lineItem.ItemID && li.TransactionID == lineItem.TransactionID {
+					details.ShipmentLineItem = &ShipmentLineItem{LineItem: []*LineItem{li}}
+				}
+			}
+		}
+		shipment.ShipmentTrackingDetails = append(shipment.ShipmentTrackingDetails, details)
+
+		if p.ShippedTime != nil && (shipment.ShippedTime == nil || p.ShippedTime.Before(*shipment.ShippedTime)) {
+			shipment.ShippedTime = p.ShippedTime
+		}
+	}
+
+	return shipment
+}
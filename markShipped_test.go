@@ -0,0 +1,182 @@
+package ebayapi
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// testOrderXML has three line items, I1/T1 already carries tracking 1Z1
+const testOrderXML = `<Order><OrderID>O1</OrderID><TransactionArray>
+<Transaction><TransactionID>T1</TransactionID><Item><ItemID>I1</ItemID></Item><ShippingDetails><ShipmentTrackingDetails>
+<ShippingCarrierUsed>UPS</ShippingCarrierUsed><ShipmentTrackingNumber>1Z1</ShipmentTrackingNumber></ShipmentTrackingDetails></ShippingDetails></Transaction>
+<Transaction><TransactionID>T2</TransactionID><Item><ItemID>I2</ItemID></Item></Transaction>
+<Transaction><TransactionID>T3</TransactionID><Item><ItemID>I3</ItemID></Item></Transaction>
+</TransactionArray></Order>`
+
+// testMarkShippedAPI serves order for GetOrders and answers every CompleteSale with completeSale
+func testMarkShippedAPI(t *testing.T, order string, completeSale func(req CompleteSaleRequest) string) (*TradingAPI, *[]CompleteSaleRequest) {
+	var sales []CompleteSaleRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-EBAY-API-CALL-NAME") == "GetOrders" {
+			fmt.Fprintf(w, `<GetOrdersResponse><Ack>Success</Ack><OrderArray>%s</OrderArray></GetOrdersResponse>`, order)
+			return
+		}
+		var req CompleteSaleRequest
+		if err := xml.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Error(err)
+		}
+		sales = append(sales, req)
+		fmt.Fprint(w, completeSale(req))
+	}))
+	t.Cleanup(srv.Close)
+
+	return NewTradingAPI(&EbayClient{baseURL: srv.URL}, nil), &sales
+}
+
+func completeSaleSuccess(CompleteSaleRequest) string {
+	return `<CompleteSaleResponse><Ack>Success</Ack></CompleteSaleResponse>`
+}
+
+func lineItems(packages []Package) map[string]string {
+	items := map[string]string{}
+	for _, p := range packages {
+		for _, li := range p.LineItems {
+			items[li.ItemID] = p.Carrier + " " + p.TrackingNumber
+		}
+	}
+
+	return items
+}
+
+func TestMarkShippedValidation(t *testing.T) {
+	api, sales := testMarkShippedAPI(t, testOrderXML, completeSaleSuccess)
+	tests := map[string][]Package{
+		"unknown carrier":        {{Carrier: "Pigeon", TrackingNumber: "1"}},
+		"missing tracking":       {{Carrier: "UPS", TrackingNumber: " - "}},
+		"mixed line items":       {{Carrier: "UPS", TrackingNumber: "1"}, {Carrier: "UPS", TrackingNumber: "2", LineItems: []*LineItem{{ItemID: "I2", TransactionID: "T2"}}}},
+		"line item not in order": {{Carrier: "UPS", TrackingNumber: "1", LineItems: []*LineItem{{ItemID: "I9", TransactionID: "T9"}}}},
+	}
+	for name, packages := range tests {
+		if _, err := api.MarkShipped(context.Background(), "O1", packages); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+	if len(*sales) != 0 {
+		t.Fatalf("%d CompleteSale calls for invalid packages", len(*sales))
+	}
+}
+
+func TestMarkShippedSplit(t *testing.T) {
+	api, sales := testMarkShippedAPI(t, testOrderXML, completeSaleSuccess)
+	res, err := api.MarkShipped(context.Background(), "O1", []Package{
+		// Uploaded before for I1 only
+		{Carrier: "ups", TrackingNumber: "1z-1", LineItems: []*LineItem{{ItemID: "I1", TransactionID: "T1"}, {ItemID: "I2", TransactionID: "T2"}}},
+		// One parcel listed once per line item it holds
+		{Carrier: "fedex", TrackingNumber: "77", LineItems: []*LineItem{{ItemID: "I2", TransactionID: "T2"}}},
+		{Carrier: "FedEx", TrackingNumber: "7 7", LineItems: []*LineItem{{ItemID: "I3", TransactionID: "T3"}, {ItemID: "I2", TransactionID: "T2"}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if dups := lineItems(res.Duplicates); len(dups) != 1 || dups["I1"] != "UPS 1Z1" {
+		t.Fatalf("duplicates %v", dups)
+	}
+	if len(res.Uploaded) != 2 || len(res.Uploaded[1].LineItems) != 2 {
+		t.Fatalf("uploaded %+v", res.Uploaded)
+	}
+
+	// One call per line item, I2 carries both its parcels
+	if len(*sales) != 2 {
+		t.Fatalf("%d CompleteSale calls, want 2", len(*sales))
+	}
+	i2, i3 := (*sales)[0], (*sales)[1]
+	if i2.ItemID != "I2" || i2.TransactionID != "T2" || len(i2.Shipment.ShipmentTrackingDetails) != 2 {
+		t.Fatalf("unexpected call for I2 %+v", i2)
+	}
+	for _, details := range i2.Shipment.ShipmentTrackingDetails {
+		if details.ShipmentLineItem == nil || details.ShipmentLineItem.LineItem[0].ItemID != "I2" {
+			t.Fatalf("tracking %s not limited to I2", details.ShipmentTrackingNumber)
+		}
+	}
+	if i3.ItemID != "I3" || len(i3.Shipment.ShipmentTrackingDetails) != 1 || i3.Shipment.ShipmentTrackingDetails[0].ShippingCarrierUsed != "FedEx" {
+		t.Fatalf("unexpected call for I3 %+v", i3)
+	}
+}
+
+func TestMarkShippedPartialFailure(t *testing.T) {
+	api, _ := testMarkShippedAPI(t, testOrderXML, func(req CompleteSaleRequest) string {
+		if req.ItemID == "I3" {
+			return `<CompleteSaleResponse><Ack>Failure</Ack><Errors><ErrorCode>10007</ErrorCode><SeverityCode>Error</SeverityCode></Errors></CompleteSaleResponse>`
+		}
+		return completeSaleSuccess(req)
+	})
+	res, err := api.MarkShipped(context.Background(), "O1", []Package{
+		{Carrier: "USPS", TrackingNumber: "9400", LineItems: []*LineItem{{ItemID: "I2", TransactionID: "T2"}, {ItemID: "I3", TransactionID: "T3"}}},
+	})
+	if err == nil {
+		t.Fatal("expected the I3 failure")
+	}
+	if uploaded := lineItems(res.Uploaded); len(uploaded) != 1 || uploaded["I2"] != "USPS 9400" {
+		t.Fatalf("uploaded %v, want I2 only", uploaded)
+	}
+}
+
+func TestMarkShippedDuplicateError(t *testing.T) {
+	duplicate := `<CompleteSaleResponse><Ack>Failure</Ack><Errors><ShortMessage>Duplicate tracking number.</ShortMessage>
+<LongMessage>The tracking number 9400 was already uploaded.</LongMessage><ErrorCode>1</ErrorCode><SeverityCode>Error</SeverityCode></Errors></CompleteSaleResponse>`
+	api, _ := testMarkShippedAPI(t, testOrderXML, func(req CompleteSaleRequest) string {
+		if req.ItemID == "I2" {
+			return duplicate
+		}
+		return completeSaleSuccess(req)
+	})
+	res, err := api.MarkShipped(context.Background(), "O1", []Package{
+		{Carrier: "USPS", TrackingNumber: "9400", LineItems: []*LineItem{{ItemID: "I2", TransactionID: "T2"}, {ItemID: "I3", TransactionID: "T3"}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dups, uploaded := lineItems(res.Duplicates), lineItems(res.Uploaded); len(dups) != 1 || dups["I2"] == "" || len(uploaded) != 1 || uploaded["I3"] == "" {
+		t.Fatalf("duplicates %v uploaded %v", dups, uploaded)
+	}
+
+	// A whole order upload naming both tracking numbers
+	api, _ = testMarkShippedAPI(t, testOrderXML, func(CompleteSaleRequest) string {
+		return `<CompleteSaleResponse><Ack>Failure</Ack><Errors><ShortMessage>Duplicate tracking number.</ShortMessage>
+<LongMessage>Tracking 9400 and 9401 already exist.</LongMessage><ErrorCode>1</ErrorCode><SeverityCode>Error</SeverityCode></Errors></CompleteSaleResponse>`
+	})
+	res, err = api.MarkShipped(context.Background(), "O1", []Package{{Carrier: "USPS", TrackingNumber: "9400"}, {Carrier: "USPS", TrackingNumber: "9401"}})
+	if err != nil || len(res.Duplicates) != 2 || len(res.Uploaded) != 0 {
+		t.Fatalf("duplicates %+v: %v", res.Duplicates, err)
+	}
+
+	// Other errors still fail
+	api, _ = testMarkShippedAPI(t, testOrderXML, func(CompleteSaleRequest) string {
+		return `<CompleteSaleResponse><Ack>Failure</Ack><Errors><ShortMessage>Invalid carrier.</ShortMessage><ErrorCode>1</ErrorCode><SeverityCode>Error</SeverityCode></Errors></CompleteSaleResponse>`
+	})
+	if _, err := api.MarkShipped(context.Background(), "O1", []Package{{Carrier: "USPS", TrackingNumber: "9400"}}); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestMarkShippedWholeOrderDuplicate(t *testing.T) {
+	order := `<Order><OrderID>O1</OrderID><ShippedTime>2024-01-02T00:00:00.000Z</ShippedTime><ShippingDetails><ShipmentTrackingDetails>
+<ShippingCarrierUsed>UPS</ShippingCarrierUsed><ShipmentTrackingNumber>1Z1</ShipmentTrackingNumber></ShipmentTrackingDetails></ShippingDetails>
+<TransactionArray><Transaction><TransactionID>T1</TransactionID><Item><ItemID>I1</ItemID></Item></Transaction></TransactionArray></Order>`
+	api, sales := testMarkShippedAPI(t, order, completeSaleSuccess)
+	res, err := api.MarkShipped(context.Background(), "O1", []Package{{Carrier: "UPS", TrackingNumber: "1z 1"}})
+	if err != nil || len(res.Duplicates) != 1 || len(res.Uploaded) != 0 || len(*sales) != 0 {
+		t.Fatalf("duplicates %+v, %d calls: %v", res.Duplicates, len(*sales), err)
+	}
+
+	// Uploaded for the whole order covers each line item too
+	res, err = api.MarkShipped(context.Background(), "O1", []Package{{Carrier: "UPS", TrackingNumber: "1Z1", LineItems: []*LineItem{{ItemID: "I1", TransactionID: "T1"}}}})
+	if err != nil || len(res.Duplicates) != 1 || len(*sales) != 0 {
+		t.Fatalf("duplicates %+v, %d calls: %v", res.Duplicates, len(*sales), err)
+	}
+}
@@ -0,0 +1,143 @@
+package ebayapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+//
+// RESTful ebay APIs (Commerce, Sell, ...) authenticate with OAuth bearer tokens rather than the Trading API
+// auth'n'auth token. The application token is obtained with the client credentials grant from AppID/CertID.
+//
+
+// DefaultOAuthScope is the public scope granted to application tokens
+const DefaultOAuthScope = "https://api.ebay.com/oauth/api_scope"
+
+// tokenExpiryMargin renews application tokens slightly before ebay expires them
+const tokenExpiryMargin = 5 * time.Minute
+
+type oauthTokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	ExpiresIn        int    `json:"expires_in"`
+	TokenType        string `json:"token_type"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// ApplicationToken returns an OAuth application access token, cached until shortly before it expires
+func (e *EbayClient) ApplicationToken(ctx context.Context) (string, error) {
+	e.tokenMu.Lock()
+	defer e.tokenMu.Unlock()
+
+	if e.appToken != "" && time.Now().Before(e.appTokenExpiry) {
+		return e.appToken, nil
+	}
+
+	form := url.Values{
+		"grant_type": {"client_credentials"},
+		"scope":      {DefaultOAuthScope},
+	}
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/identity/v1/oauth2/token", e.baseURL), strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req = req.WithContext(ctx)
+	req.SetBasicAuth(e.AppID, e.CertID)
+	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+	if e.logger != nil {
+		e.logger.Debug("REQUESTING: OAuth application token")
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	bodyContents, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != 200 {
+		return "", httpError{statusCode: resp.StatusCode, body: bodyContents}
+	}
+
+	var token oauthTokenResponse
+	if err := json.Unmarshal(bodyContents, &token); err != nil {
+		return "", err
+	}
+	if token.AccessToken == "" {
+		return "", errors.New("ERROR[ApplicationToken]: " + token.Error + " " + token.ErrorDescription)
+	}
+
+	e.appToken = token.AccessToken
+	e.appTokenExpiry = time.Now().Add(time.Duration(token.ExpiresIn)*time.Second - tokenExpiryMargin)
+	return e.appToken, nil
+}
+
+// doOAuthJSONcall performs a JSON request against a RESTful API authorized with the application token
+// and decodes the JSON response into out when it is not nil
+func (e *EbayClient) doOAuthJSONcall(ctx context.Context, method, path string, in, out interface{}) error {
+	token, err := e.ApplicationToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, e.baseURL+path, body)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Add("Authorization", "Bearer "+token)
+	req.Header.Add("Accept", "application/json")
+	if in != nil {
+		req.Header.Add("Content-Type", "application/json")
+	}
+
+	if e.logger != nil {
+		e.logger.Debug("REQUESTING: ", method, " ", req.URL.String())
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	bodyContents, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if e.logger != nil {
+		e.logger.Debug("RESPONSE: ", method, " ", req.URL.String(), ": ", string(bodyContents))
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return httpError{statusCode: resp.StatusCode, body: bodyContents}
+	}
+	if out == nil || len(bodyContents) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(bodyContents, out)
+}
@@ -0,0 +1,399 @@
+package ebayapi
+
+import "time"
+
+//
+// Order model returned by GetOrders, following the OrderType/TransactionType schema. Money fields use Price,
+// optional times are pointers so unset values can be told apart from the zero time.
+//
+
+// OrderStatus values
+const (
+	OrderStatusActive        = "Active"
+	OrderStatusCancelled     = "Cancelled"
+	OrderStatusCancelPending = "CancelPending"
+	OrderStatusCompleted     = "Completed"
+	OrderStatusInactive      = "Inactive"
+	OrderStatusShipped       = "Shipped"
+	OrderStatusAll           = "All"
+)
+
+// Order ebay api type
+type Order struct {
+	OrderID                             string
+	ExtendedOrderID                     string
+	OrderStatus                         string
+	CancelStatus                        string
+	CancelReason                        string
+	CancelReasonDetails                 string
+	CancelDetail                        []CancelDetail `xml:"CancelDetail"`
+	BuyerUserID                         string
+	BuyerCheckoutMessage                string
+	BuyerTaxIdentifier                  []TaxIdentifier `xml:"BuyerTaxIdentifier"`
+	EIASToken                           string
+	SellerUserID                        string
+	SellerEmail                         string
+	SellerEIASToken                     string
+	CreatingUserRole                    string
+	CreatedTime                         time.Time
+	PaidTime                            *time.Time `xml:",omitempty"`
+	ShippedTime                         *time.Time `xml:",omitempty"`
+	CheckoutStatus                      CheckoutStatus
+	PaymentMethods                      []string `xml:"PaymentMethods"`
+	PaymentHoldStatus                   string
+	PaymentHoldDetails                  *PaymentHoldDetails `xml:",omitempty"`
+	IntegratedMerchantCreditCardEnabled bool
+	MonetaryDetails                     MonetaryDetails
+	AdjustmentAmount                    Price
+	AmountPaid                          Price
+	AmountSaved                         Price
+	Subtotal                            Price
+	ShippingConvenienceCharge           *Price `xml:",omitempty"`
+	Total                               Price
+	EBayCollectAndRemitTax              bool `xml:"eBayCollectAndRemitTax"`
+	ContainseBayPlusTransaction         bool
+	IsMultiLegShipping                  bool
+	MultiLegShippingDetails             *MultiLegShippingDetails `xml:",omitempty"`
+	LogisticsPlanType                   string
+	PickupMethodSelected                *PickupMethodSelected `xml:",omitempty"`
+	ShippingAddress                     Address
+	ShippingDetails                     OrderShippingDetails
+	ShippingServiceSelected             ShippingServiceSelected
+	TransactionArray                    struct {
+		Transaction []Transaction `xml:"Transaction"`
+	} `xml:"TransactionArray"`
+}
+
+// Paid reports whether the buyer completed payment
+func (o Order) Paid() bool {
+	return o.PaidTime != nil && !o.PaidTime.IsZero()
+}
+
+// Shipped reports whether the order was marked as shipped
+func (o Order) Shipped() bool {
+	return o.ShippedTime != nil && !o.ShippedTime.IsZero()
+}
+
+// Address type - shipping and registration addresses
+type Address struct {
+	AddressID         string
+	AddressOwner      string
+	AddressUsage      string
+	ExternalAddressID string
+	ReferenceID       string
+	Name              string
+	FirstName         string
+	LastName          string
+	CompanyName       string
+	Street1           string
+	Street2           string
+	CityName          string
+	StateOrProvince   string
+	PostalCode        string
+	Country           string
+	CountryName       string
+	Phone             string
+	AddressAttribute  []AddressAttribute `xml:"AddressAttribute"`
+}
+
+// AddressAttribute type - e.g. the ReferenceNumber of eBay International Shipping addresses
+type AddressAttribute struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+// CancelDetail type
+type CancelDetail struct {
+	CancelCompleteDate  *time.Time `xml:",omitempty"`
+	CancelIntiationDate *time.Time `xml:",omitempty"`
+	CancelIntiator      string
+	CancelReason        string
+	CancelReasonDetails string
+}
+
+// TaxIdentifier type - buyer VAT or tax IDs
+type TaxIdentifier struct {
+	Type string
+	ID   string
+}
+
+// CheckoutStatus type
+type CheckoutStatus struct {
+	EBayPaymentStatus                   string `xml:"eBayPaymentStatus"`
+	IntegratedMerchantCreditCardEnabled bool
+	LastModifiedTime                    time.Time
+	PaymentInstrument                   string
+	PaymentMethod                       string
+	Status                              string
+}
+
+// PaymentHoldDetails type
+type PaymentHoldDetails struct {
+	ExpectedReleaseDate       *time.Time `xml:",omitempty"`
+	NumOfReqSellerActions     int
+	PaymentHoldReason         string
+	RequiredSellerActionArray struct {
+		RequiredSellerAction []string `xml:"RequiredSellerAction"`
+	} `xml:"RequiredSellerActionArray"`
+}
+
+// MonetaryDetails type
+type MonetaryDetails struct {
+	Payments struct {
+		Payment []Payment `xml:"Payment"`
+	} `xml:"Payments"`
+	Refunds struct {
+		Refund []Refund `xml:"Refund"`
+	} `xml:"Refunds"`
+}
+
+// Payment type
+type Payment struct {
+	FeeOrCreditAmount  Price
+	Payee              string
+	Payer              string
+	PaymentAmount      Price
+	PaymentReferenceID string
+	PaymentStatus      string
+	PaymentTime        time.Time
+	ReferenceID        string
+}
+
+// Refund type
+type Refund struct {
+	FeeOrCreditAmount Price
+	ReferenceID       string
+	RefundAmount      Price
+	RefundStatus      string
+	RefundTime        time.Time
+	RefundTo          string
+	RefundType        string
+}
+
+// MultiLegShippingDetails type - shipping to a logistics provider such as the Global Shipping Program
+type MultiLegShippingDetails struct {
+	SellerShipmentToLogisticsProvider struct {
+		ShipToAddress          Address
+		ShippingServiceDetails struct {
+			ShippingService   string
+			TotalShippingCost Price
+		} `xml:"ShippingServiceDetails"`
+		ShippingTimeMax int
+		ShippingTimeMin int
+	} `xml:"SellerShipmentToLogisticsProvider"`
+}
+
+// PickupMethodSelected type - In-Store Pickup and Click and Collect orders
+type PickupMethodSelected struct {
+	MerchantPickupCode    string
+	PickupFulfillmentTime *time.Time `xml:",omitempty"`
+	PickupLocationUUID    string
+	PickupMethod          string
+	PickupStatus          string
+	PickupStoreID         string
+}
+
+// OrderShippingDetails type - the shipping options of an order or transaction
+type OrderShippingDetails struct {
+	SellingManagerSalesRecordNumber    int
+	GetItFast                          bool
+	CODCost                            *Price                               `xml:",omitempty"`
+	SalesTax                           *SalesTax                            `xml:",omitempty"`
+	TaxTable                           *TaxTable                            `xml:",omitempty"`
+	ShippingServiceOptions             []ShippingServiceOptions             `xml:"ShippingServiceOptions"`
+	InternationalShippingServiceOption []InternationalShippingServiceOption `xml:"InternationalShippingServiceOption"`
+	ShipmentTrackingDetails            []ShipmentTrackingDetails            `xml:"ShipmentTrackingDetails"`
+}
+
+// SalesTax type
+type SalesTax struct {
+	SalesTaxAmount        Price
+	SalesTaxPercent       float64
+	SalesTaxState         string
+	ShippingIncludedInTax bool
+}
+
+// ShippingServiceSelected type - the shipping service chosen by the buyer
+type ShippingServiceSelected struct {
+	ShippingService         string
+	ShippingServiceCost     Price
+	ShippingServicePriority int
+	ShippingInsuranceCost   *Price `xml:",omitempty"`
+	ImportCharge            *Price `xml:",omitempty"`
+	ExpeditedService        bool
+	ShippingTimeMin         int
+	ShippingTimeMax         int
+	ShippingPackageInfo     []ShippingPackageInfo `xml:"ShippingPackageInfo"`
+}
+
+// ShippingPackageInfo type - estimated and actual delivery times of a package
+type ShippingPackageInfo struct {
+	StoreID                        string
+	ShippingTrackingEvent          string
+	HandleByTime                   *time.Time `xml:",omitempty"`
+	EstimatedDeliveryTimeMin       *time.Time `xml:",omitempty"`
+	EstimatedDeliveryTimeMax       *time.Time `xml:",omitempty"`
+	MinNativeEstimatedDeliveryTime *time.Time `xml:",omitempty"`
+	MaxNativeEstimatedDeliveryTime *time.Time `xml:",omitempty"`
+	ScheduledDeliveryTimeMin       *time.Time `xml:",omitempty"`
+	ScheduledDeliveryTimeMax       *time.Time `xml:",omitempty"`
+	ActualDeliveryTime             *time.Time `xml:",omitempty"`
+}
+
+// Transaction ebay api type - an order line item
+type Transaction struct {
+	TransactionID             string
+	OrderLineItemID           string
+	ExtendedOrderID           string
+	InventoryReservationID    string
+	TransactionSiteID         string
+	Platform                  string
+	CreatedDate               time.Time
+	PaidTime                  *time.Time `xml:",omitempty"`
+	ShippedTime               *time.Time `xml:",omitempty"`
+	InvoiceSentTime           *time.Time `xml:",omitempty"`
+	Buyer                     TransactionBuyer
+	BuyerCheckoutMessage      string
+	Item                      TransactionItem
+	Variation                 *TransactionVariation `xml:",omitempty"`
+	QuantityPurchased         int32
+	TransactionPrice          Price
+	FinalValueFee             *Price           `xml:",omitempty"`
+	ActualHandlingCost        *Price           `xml:",omitempty"`
+	ActualShippingCost        *Price           `xml:",omitempty"`
+	ShippingConvenienceCharge *Price           `xml:",omitempty"`
+	SellerDiscounts           *SellerDiscounts `xml:",omitempty"`
+	ShippingDetails           OrderShippingDetails
+	ShippingServiceSelected   ShippingServiceSelected
+	Status                    TransactionStatus
+	UnpaidItem                *UnpaidItem              `xml:",omitempty"`
+	PaymentHoldDetails        *PaymentHoldDetails      `xml:",omitempty"`
+	ExternalTransaction       []ExternalTransaction    `xml:"ExternalTransaction"`
+	MultiLegShippingDetails   *MultiLegShippingDetails `xml:",omitempty"`
+	LogisticsPlanType         string
+	Program                   *TransactionProgram `xml:",omitempty"`
+	EBayCollectAndRemitTax    bool                `xml:"eBayCollectAndRemitTax"`
+	EBayCollectAndRemitTaxes  *Taxes              `xml:"eBayCollectAndRemitTaxes,omitempty"`
+	Taxes                     *Taxes              `xml:",omitempty"`
+	EBayPlusTransaction       bool                `xml:"eBayPlusTransaction"`
+	FeedbackLeft              *FeedbackInfo       `xml:",omitempty"`
+	FeedbackReceived          *FeedbackInfo       `xml:",omitempty"`
+	GuaranteedDelivery        bool
+	GuaranteedShipping        bool
+	GiftSummary               *struct {
+		Message string
+	} `xml:",omitempty"`
+}
+
+// TransactionBuyer type
+type TransactionBuyer struct {
+	UserID        string
+	Email         string
+	StaticAlias   string
+	UserFirstName string
+	UserLastName  string
+	VATStatus     string
+	BuyerInfo     struct {
+		ShippingAddress Address
+	} `xml:"BuyerInfo"`
+}
+
+// TransactionItem type - the listing a transaction was bought from
+type TransactionItem struct {
+	ItemID                              string
+	Site                                string
+	Title                               string
+	SKU                                 string
+	ConditionID                         int
+	ConditionDisplayName                string
+	IntegratedMerchantCreditCardEnabled bool
+}
+
+// TransactionVariation type - the variation bought from a multi-variation listing
+type TransactionVariation struct {
+	SKU                  string
+	VariationSpecifics   *NameValueListArray `xml:",omitempty"`
+	VariationTitle       string
+	VariationViewItemURL string
+}
+
+// TransactionStatus type
+type TransactionStatus struct {
+	BuyerSelectedShipping               bool
+	CancelStatus                        string
+	CheckoutStatus                      string
+	CompleteStatus                      string
+	EBayPaymentStatus                   string `xml:"eBayPaymentStatus"`
+	InquiryStatus                       string
+	IntegratedMerchantCreditCardEnabled bool
+	LastTimeModified                    *time.Time `xml:",omitempty"`
+	PaymentHoldStatus                   string
+	PaymentMethodUsed                   string
+	ReturnStatus                        string
+}
+
+// UnpaidItem type - unpaid item case opened on the transaction
+type UnpaidItem struct {
+	Status string
+	Type   string
+}
+
+// SellerDiscounts type - promotions applied to the line item
+type SellerDiscounts struct {
+	OriginalItemPrice        Price
+	OriginalItemShippingCost Price
+	OriginalShippingService  string
+	SellerDiscount           []SellerDiscount `xml:"SellerDiscount"`
+}
+
+// SellerDiscount type
+type SellerDiscount struct {
+	CampaignDisplayName    string
+	CampaignID             int64
+	ItemDiscountAmount     Price
+	ShippingDiscountAmount Price
+}
+
+// ExternalTransaction type - payment or refund processed outside of ebay
+type ExternalTransaction struct {
+	ExternalTransactionID     string
+	ExternalTransactionStatus string
+	ExternalTransactionTime   *time.Time `xml:",omitempty"`
+	FeeOrCreditAmount         Price
+	PaymentOrRefundAmount     Price
+}
+
+// TransactionProgram type - eBay programs the line item is handled by, e.g. eBay fulfillment or authenticity
+// verification
+type TransactionProgram struct {
+	AuthenticityVerification *struct {
+		OutcomeReason string
+		Status        string
+	} `xml:",omitempty"`
+	Fulfillment *struct {
+		FulfilledBy    string
+		FulfillmentRef string
+		ProgramType    string
+	} `xml:",omitempty"`
+}
+
+// Taxes type - sales taxes, eBay Collect and Remit taxes use the same structure
+type Taxes struct {
+	TotalTaxAmount Price
+	TaxDetails     []TaxDetails `xml:"TaxDetails"`
+	EBayReference  []struct {
+		Name  string `xml:"name,attr"`
+		Value string `xml:",chardata"`
+	} `xml:"eBayReference"`
+}
+
+// TaxDetails type - one tax imposition such as SalesTax, WasteRecyclingFee or GST
+type TaxDetails struct {
+	Imposition          string
+	TaxDescription      string
+	CollectionMethod    string
+	TaxAmount           Price
+	TaxOnSubtotalAmount Price
+	TaxOnShippingAmount Price
+	TaxOnHandlingAmount Price
+}
@@ -0,0 +1,450 @@
+package ebayapi
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Order export formats
+const (
+	ExportCSV     = "csv"
+	ExportJSONL   = "jsonl"
+	ExportParquet = "parquet"
+)
+
+// MoneyFormat selects how Price columns are written
+type MoneyFormat int
+
+// Money formats
+const (
+	// MoneyWithCurrency writes the amount followed by the CurrencyID, e.g. "12.50 USD"
+	MoneyWithCurrency MoneyFormat = iota
+	// MoneyAmount writes the amount only
+	MoneyAmount
+	// MoneyCurrencyColumn writes the amount and adds a <column>_currency column holding the CurrencyID
+	MoneyCurrencyColumn
+)
+
+// zeroDecimalCurrencies are written without minor units
+var zeroDecimalCurrencies = map[string]bool{"JPY": true, "KRW": true, "TWD": true}
+
+// OrderColumn is an exported column. Value receives the order and one of its transactions, and may return
+// a string, number, bool, Price, time.Time or a pointer to one of those - nil and zero times are written empty
+type OrderColumn struct {
+	Name string
+	// Money marks columns returning Price, needed to add currency columns with MoneyCurrencyColumn
+	Money bool
+	Value func(o *Order, t *Transaction) interface{}
+}
+
+// OrderColumns are the built in columns, order level values repeat on every line item row of an order, so
+// sums of order totals should only count rows with line_number 1
+var OrderColumns = []OrderColumn{
+	{Name: "order_id", Value: func(o *Order, t *Transaction) interface{} { return o.OrderID }},
+	{Name: "extended_order_id", Value: func(o *Order, t *Transaction) interface{} { return o.ExtendedOrderID }},
+	{Name: "order_status", Value: func(o *Order, t *Transaction) interface{} { return o.OrderStatus }},
+	{Name: "created_time", Value: func(o *Order, t *Transaction) interface{} { return o.CreatedTime }},
+	{Name: "paid_time", Value: func(o *Order, t *Transaction) interface{} { return o.PaidTime }},
+	{Name: "shipped_time", Value: func(o *Order, t *Transaction) interface{} { return o.ShippedTime }},
+	{Name: "last_modified_time", Value: func(o *Order, t *Transaction) interface{} { return o.CheckoutStatus.LastModifiedTime }},
+	{Name: "payment_status", Value: func(o *Order, t *Transaction) interface{} { return o.CheckoutStatus.EBayPaymentStatus }},
+	{Name: "buyer_user_id", Value: func(o *Order, t *Transaction) interface{} { return o.BuyerUserID }},
+	{Name: "buyer_email", Value: func(o *Order, t *Transaction) interface{} { return t.Buyer.Email }},
+	{Name: "seller_user_id", Value: func(o *Order, t *Transaction) interface{} { return o.SellerUserID }},
+	{Name: "sales_record_number", Value: func(o *Order, t *Transaction) interface{} { return t.ShippingDetails.SellingManagerSalesRecordNumber }},
+	{Name: "line_number", Value: func(o *Order, t *Transaction) interface{} {
+		for i := range o.TransactionArray.Transaction {
+			if &o.TransactionArray.Transaction[i] == t {
+				return i + 1
+			}
+		}
+		return 1
+	}},
+	{Name: "line_count", Value: func(o *Order, t *Transaction) interface{} { return len(o.TransactionArray.Transaction) }},
+	{Name: "order_line_item_id", Value: func(o *Order, t *Transaction) interface{} { return t.OrderLineItemID }},
+	{Name: "item_id", Value: func(o *Order, t *Transaction) interface{} { return t.Item.ItemID }},
+	{Name: "transaction_id", Value: func(o *Order, t *Transaction) interface{} { return t.TransactionID }},
+	{Name: "sku", Value: func(o *Order, t *Transaction) interface{} {
+		if t.Variation != nil && t.Variation.SKU != "" {
+			return t.Variation.SKU
+		}
+		return t.Item.SKU
+	}},
+	{Name: "title", Value: func(o *Order, t *Transaction) interface{} { return t.Item.Title }},
+	{Name: "variation_title", Value: func(o *Order, t *Transaction) interface{} {
+		if t.Variation != nil {
+			return t.Variation.VariationTitle
+		}
+		return nil
+	}},
+	{Name: "quantity", Value: func(o *Order, t *Transaction) interface{} { return t.QuantityPurchased }},
+	{Name: "unit_price", Money: true, Value: func(o *Order, t *Transaction) interface{} { return t.TransactionPrice }},
+	{Name: "line_total", Money: true, Value: func(o *Order, t *Transaction) interface{} {
+		return Price{Amount: t.TransactionPrice.Amount * float64(t.QuantityPurchased), CurrencyID: t.TransactionPrice.CurrencyID}
+	}},
+	{Name: "final_value_fee", Money: true, Value: func(o *Order, t *Transaction) interface{} { return t.FinalValueFee }},
+	{Name: "order_subtotal", Money: true, Value: func(o *Order, t *Transaction) interface{} { return o.Subtotal }},
+	{Name: "order_shipping_cost", Money: true, Value: func(o *Order, t *Transaction) interface{} {
+		return o.ShippingServiceSelected.ShippingServiceCost
+	}},
+	{Name: "order_total", Money: true, Value: func(o *Order, t *Transaction) interface{} { return o.Total }},
+	{Name: "amount_paid", Money: true, Value: func(o *Order, t *Transaction) interface{} { return o.AmountPaid }},
+	{Name: "collect_and_remit_tax", Value: func(o *Order, t *Transaction) interface{} { return t.EBayCollectAndRemitTax }},
+	{Name: "shipping_service", Value: func(o *Order, t *Transaction) interface{} { return o.ShippingServiceSelected.ShippingService }},
+	{Name: "ship_to_name", Value: func(o *Order, t *Transaction) interface{} { return o.ShippingAddress.Name }},
+	{Name: "ship_to_street1", Value: func(o *Order, t *Transaction) interface{} { return o.ShippingAddress.Street1 }},
+	{Name: "ship_to_street2", Value: func(o *Order, t *Transaction) interface{} { return o.ShippingAddress.Street2 }},
+	{Name: "ship_to_city", Value: func(o *Order, t *Transaction) interface{} { return o.ShippingAddress.CityName }},
+	{Name: "ship_to_state", Value: func(o *Order, t *Transaction) interface{} { return o.ShippingAddress.StateOrProvince }},
+	{Name: "ship_to_postal_code", Value: func(o *Order, t *Transaction) interface{} { return o.ShippingAddress.PostalCode }},
+	{Name: "ship_to_country", Value: func(o *Order, t *Transaction) interface{} { return o.ShippingAddress.Country }},
+	{Name: "ship_to_phone", Value: func(o *Order, t *Transaction) interface{} { return o.ShippingAddress.Phone }},
+	{Name: "carriers", Value: func(o *Order, t *Transaction) interface{} {
+		return joinTracking(o, t, func(d ShipmentTrackingDetails) string { return d.ShippingCarrierUsed })
+	}},
+	{Name: "tracking_numbers", Value: func(o *Order, t *Transaction) interface{} {
+		return joinTracking(o, t, func(d ShipmentTrackingDetails) string { return d.ShipmentTrackingNumber })
+	}},
+}
+
+// DefaultOrderColumns are exported when no columns are selected
+var DefaultOrderColumns = []string{
+	"order_id", "created_time", "paid_time", "shipped_time", "order_status", "buyer_user_id",
+	"line_number", "item_id", "transaction_id", "sku", "title", "quantity", "unit_price", "line_total",
+	"order_subtotal", "order_shipping_cost", "order_total", "ship_to_name", "ship_to_city",
+	"ship_to_postal_code", "ship_to_country", "tracking_numbers",
+}
+
+// joinTracking lists the tracking details of a line item, falling back to the order level details
+func joinTracking(o *Order, t *Transaction, field func(ShipmentTrackingDetails) string) string {
+	details := t.ShippingDetails.ShipmentTrackingDetails
+	if len(details) == 0 {
+		details = o.ShippingDetails.ShipmentTrackingDetails
+	}
+
+	var values []string
+	for _, d := range details {
+		values = append(values, field(d))
+	}
+
+	return strings.Join(values, " ")
+}
+
+// OrderExporter flattens orders to one row per line item and writes them as CSV, JSON Lines or Parquet.
+// Values are formatted the same in every format: JSON Lines and Parquet hold strings, empty values being null
+type OrderExporter struct {
+	Columns []OrderColumn
+	Money   MoneyFormat
+	// Location converts times, defaults to UTC
+	Location *time.Location
+	// TimeLayout formats times, defaults to RFC3339
+	TimeLayout string
+}
+
+// NewOrderExporter instantiates an exporter of the named built in columns, DefaultOrderColumns if none given
+func NewOrderExporter(columns ...string) (*OrderExporter, error) {
+	if len(columns) == 0 {
+		columns = DefaultOrderColumns
+	}
+
+	e := &OrderExporter{}
+	for _, name := range columns {
+		col, ok := orderColumn(name)
+		if !ok {
+			return nil, fmt.Errorf("ERROR[OrderExporter]: unknown column %q", name)
+		}
+		e.Columns = append(e.Columns, col)
+	}
+
+	return e, nil
+}
+
+func orderColumn(name string) (OrderColumn, bool) {
+	for _, col := range OrderColumns {
+		if col.Name == name {
+			return col, true
+		}
+	}
+
+	return OrderColumn{}, false
+}
+
+// Header returns the column names, including the currency columns of MoneyCurrencyColumn
+func (e *OrderExporter) Header() []string {
+	var header []string
+	for _, col := range e.Columns {
+		header = append(header, col.Name)
+		if col.Money && e.Money == MoneyCurrencyColumn {
+			header = append(header, col.Name+"_currency")
+		}
+	}
+
+	return header
+}
+
+// validate checks the columns can be written
+func (e *OrderExporter) validate() error {
+	if len(e.Columns) == 0 {
+		return fmt.Errorf("ERROR[OrderExporter]: no columns selected")
+	}
+
+	seen := map[string]bool{}
+	for _, name := range e.Header() {
+		if seen[name] {
+			return fmt.Errorf("ERROR[OrderExporter]: duplicate column %q", name)
+		}
+		seen[name] = true
+	}
+
+	return nil
+}
+
+// Rows flattens orders to one row per line item, in Header order. Orders without transactions, e.g. when
+// the TransactionArray was not selected, give a single row of order level values
+func (e *OrderExporter) Rows(orders []Order) [][]*string {
+	var rows [][]*string
+	for i := range orders {
+		order := &orders[i]
+		transactions := order.TransactionArray.Transaction
+		if len(transactions) == 0 {
+			transactions = []Transaction{{}}
+		}
+
+		for j := range transactions {
+			var row []*string
+			for _, col := range e.Columns {
+				row = append(row, e.cells(col, col.Value(order, &transactions[j]))...)
+			}
+			rows = append(rows, row)
+		}
+	}
+
+	return rows
+}
+
+// cells formats a column value, nil meaning an empty value
+func (e *OrderExporter) cells(col OrderColumn, value interface{}) []*string {
+	if price, ok := value.(*Price); ok {
+		if price == nil {
+			value = nil
+		} else {
+			value = *price
+		}
+	}
+	// A zero Price without currency was not returned by ebay, e.g. not selected or no transaction
+	if price, ok := value.(Price); ok && price.Amount == 0 && price.CurrencyID == "" {
+		value = nil
+	}
+
+	if price, ok := value.(Price); ok {
+		amount := e.amount(price)
+		switch e.Money {
+		case MoneyAmount:
+			return []*string{&amount}
+		case MoneyCurrencyColumn:
+			currency := price.CurrencyID
+			return []*string{&amount, &currency}
+		default:
+			s := strings.TrimSpace(amount + " " + price.CurrencyID)
+			return []*string{&s}
+		}
+	}
+
+	var cell *string
+	if s, ok := e.format(value); ok {
+		cell = &s
+	}
+	if col.Money && e.Money == MoneyCurrencyColumn {
+		return []*string{cell, nil}
+	}
+
+	return []*string{cell}
+}
+
+// amount formats a price with the minor units of its currency
+func (e *OrderExporter) amount(price Price) string {
+	decimals := 2
+	if zeroDecimalCurrencies[price.CurrencyID] {
+		decimals = 0
+	}
+
+	return strconv.FormatFloat(price.Amount, 'f', decimals, 64)
+}
+
+func (e *OrderExporter) format(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case nil:
+		return "", false
+	case string:
+		return v, true
+	case *time.Time:
+		if v == nil {
+			return "", false
+		}
+		return e.format(*v)
+	case time.Time:
+		if v.IsZero() {
+			return "", false
+		}
+		loc := e.Location
+		if loc == nil {
+			loc = time.UTC
+		}
+		layout := e.TimeLayout
+		if layout == "" {
+			layout = time.RFC3339
+		}
+		return v.In(loc).Format(layout), true
+	case *string:
+		if v == nil {
+			return "", false
+		}
+		return *v, true
+	case *bool:
+		if v == nil {
+			return "", false
+		}
+		return strconv.FormatBool(*v), true
+	default:
+		return fmt.Sprint(v), true
+	}
+}
+
+// Export writes orders in the given format
+func (e *OrderExporter) Export(w io.Writer, format string, orders []Order) error {
+	ow, err := e.NewWriter(w, format)
+	if err != nil {
+		return err
+	}
+	if err := ow.Write(orders); err != nil {
+		return err
+	}
+
+	return ow.Close()
+}
+
+// NewWriter returns a writer to export orders in batches, e.g. page by page. Parquet output is buffered
+// and written on Close
+func (e *OrderExporter) NewWriter(w io.Writer, format string) (*OrderWriter, error) {
+	if err := e.validate(); err != nil {
+		return nil, err
+	}
+
+	ow := &OrderWriter{exporter: e, header: e.Header()}
+	switch format {
+	case ExportCSV:
+		ow.csv = csv.NewWriter(w)
+		if err := ow.csv.Write(ow.header); err != nil {
+			return nil, err
+		}
+	case ExportJSONL:
+		ow.jsonl = w
+	case ExportParquet:
+		ow.parquet = newParquetWriter(w, ow.header)
+	default:
+		return nil, fmt.Errorf("ERROR[OrderExporter]: unknown format %q", format)
+	}
+
+	return ow, nil
+}
+
+// OrderWriter writes flattened orders in one format
+type OrderWriter struct {
+	exporter *OrderExporter
+	header   []string
+	csv      *csv.Writer
+	jsonl    io.Writer
+	parquet  *parquetWriter
+}
+
+// Write writes the line item rows of orders
+func (ow *OrderWriter) Write(orders []Order) error {
+	for _, row := range ow.exporter.Rows(orders) {
+		var err error
+		switch {
+		case ow.csv != nil:
+			record := make([]string, len(row))
+			for i, cell := range row {
+				if cell != nil {
+					record[i] = *cell
+				}
+			}
+			err = ow.csv.Write(record)
+		case ow.jsonl != nil:
+			// Keep the column order, a map would sort the keys
+			line := []byte{'{'}
+			for i, cell := range row {
+				if i > 0 {
+					line = append(line, ',')
+				}
+				key, _ := json.Marshal(ow.header[i])
+				value, _ := json.Marshal(cell)
+				line = append(append(append(line, key...), ':'), value...)
+			}
+			_, err = ow.jsonl.Write(append(line, '}', '\n'))
+		default:
+			err = ow.parquet.WriteRow(row)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if ow.csv != nil {
+		ow.csv.Flush()
+		return ow.csv.Error()
+	}
+
+	return nil
+}
+
+// Close completes the output, required for Parquet
+func (ow *OrderWriter) Close() error {
+	if ow.parquet != nil {
+		return ow.parquet.Close()
+	}
+
+	return nil
+}
+
+// ExportOrders pages through GetOrders and writes the orders with exporter, one page in memory at a time
+// except for Parquet output
+func (api *TradingAPI) ExportOrders(ctx context.Context, req *GetOrdersRequest, exporter *OrderExporter, format string, w io.Writer) (int, error) {
+	ow, err := exporter.NewWriter(w, format)
+	if err != nil {
+		return 0, err
+	}
+
+	page := *req
+	entries := MaxEntriesPerPage
+	if req.Pagination != nil && req.Pagination.EntriesPerPage > 0 {
+		entries = req.Pagination.EntriesPerPage
+	}
+
+	count := 0
+	for p := 1; ; p++ {
+		page.Pagination = &Pagination{EntriesPerPage: entries, PageNumber: p}
+		resp, err := api.GetOrdersPage(ctx, &page)
+		if err != nil {
+			api.logError("FAILED GetOrders call: ", err)
+			return count, err
+		}
+
+		if err := ow.Write(resp.OrderArray.Orders); err != nil {
+			return count, err
+		}
+		count += len(resp.OrderArray.Orders)
+
+		if !resp.HasMoreOrders || p >= resp.PaginationResult.TotalNumberOfPages {
+			break
+		}
+	}
+
+	return count, ow.Close()
+}
@@ -0,0 +1,167 @@
+package ebayapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testExportOrder = `<Order><OrderID>1-2</OrderID><OrderStatus>Completed</OrderStatus>
+<CreatedTime>2024-03-01T23:30:00.000Z</CreatedTime>
+<Subtotal currencyID="USD">30.0</Subtotal><Total currencyID="USD">35.5</Total>
+<ShippingAddress><Name>Jo, "Q"</Name><Country>US</Country></ShippingAddress>
+<TransactionArray>
+<Transaction><TransactionID>t1</TransactionID><Item><ItemID>i1</ItemID><SKU>S1</SKU></Item>
+<QuantityPurchased>2</QuantityPurchased><TransactionPrice currencyID="USD">10.0</TransactionPrice>
+<ShippingDetails><ShipmentTrackingDetails><ShipmentTrackingNumber>TN1</ShipmentTrackingNumber>
+<ShippingCarrierUsed>UPS</ShippingCarrierUsed></ShipmentTrackingDetails></ShippingDetails></Transaction>
+<Transaction><TransactionID>t2</TransactionID><Item><ItemID>i2</ItemID></Item><Variation><SKU>V2</SKU></Variation>
+<QuantityPurchased>1</QuantityPurchased><TransactionPrice currencyID="USD">10</TransactionPrice></Transaction>
+</TransactionArray></Order>`
+
+func testExportOrders(t *testing.T, orders ...string) []Order {
+	var resp GetOrdersResponse
+	data := `<GetOrdersResponse><OrderArray>` + strings.Join(orders, "") + `</OrderArray></GetOrdersResponse>`
+	if err := xml.Unmarshal([]byte(data), &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	return resp.OrderArray.Orders
+}
+
+func TestOrderExporterCSV(t *testing.T) {
+	e, err := NewOrderExporter("order_id", "created_time", "line_number", "sku", "quantity", "line_total",
+		"order_total", "ship_to_name", "tracking_numbers", "paid_time")
+	if err != nil {
+		t.Fatal(err)
+	}
+	e.Location, _ = time.LoadLocation("America/New_York")
+
+	var buf bytes.Buffer
+	if err := e.Export(&buf, ExportCSV, testExportOrders(t, testExportOrder)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `order_id,created_time,line_number,sku,quantity,line_total,order_total,ship_to_name,tracking_numbers,paid_time
+1-2,2024-03-01T18:30:00-05:00,1,S1,2,20.00 USD,35.50 USD,"Jo, ""Q""",TN1,
+1-2,2024-03-01T18:30:00-05:00,2,V2,1,10.00 USD,35.50 USD,"Jo, ""Q""",,
+`
+	if buf.String() != want {
+		t.Fatalf("got\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func TestOrderExporterJSONL(t *testing.T) {
+	e, _ := NewOrderExporter("order_id", "unit_price", "final_value_fee", "paid_time")
+	e.Money = MoneyCurrencyColumn
+
+	var buf bytes.Buffer
+	if err := e.Export(&buf, ExportJSONL, testExportOrders(t, testExportOrder)); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"order_id":"1-2","unit_price":"10.00","unit_price_currency":"USD","final_value_fee":null,"final_value_fee_currency":null,"paid_time":null}
+{"order_id":"1-2","unit_price":"10.00","unit_price_currency":"USD","final_value_fee":null,"final_value_fee_currency":null,"paid_time":null}
+`
+	if buf.String() != want {
+		t.Fatalf("got\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func TestOrderExporterMoney(t *testing.T) {
+	tests := []struct {
+		money MoneyFormat
+		price interface{}
+		want  []string
+	}{
+		{MoneyWithCurrency, Price{Amount: 12.5, CurrencyID: "USD"}, []string{"12.50 USD"}},
+		{MoneyWithCurrency, &Price{Amount: 1200, CurrencyID: "JPY"}, []string{"1200 JPY"}},
+		{MoneyAmount, Price{Amount: 0, CurrencyID: "EUR"}, []string{"0.00"}},
+		{MoneyCurrencyColumn, Price{Amount: 3, CurrencyID: "GBP"}, []string{"3.00", "GBP"}},
+		// Prices ebay did not return are missing, not zero
+		{MoneyWithCurrency, Price{}, []string{"<nil>"}},
+		{MoneyCurrencyColumn, Price{}, []string{"<nil>", "<nil>"}},
+		{MoneyAmount, (*Price)(nil), []string{"<nil>"}},
+	}
+
+	for _, tt := range tests {
+		e := &OrderExporter{Money: tt.money}
+		var got []string
+		for _, cell := range e.cells(OrderColumn{Name: "price", Money: true}, tt.price) {
+			if cell == nil {
+				got = append(got, "<nil>")
+			} else {
+				got = append(got, *cell)
+			}
+		}
+		if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("%#v with format %d = %v, want %v", tt.price, tt.money, got, tt.want)
+		}
+	}
+}
+
+func TestOrderExporterWithoutTransactions(t *testing.T) {
+	e, _ := NewOrderExporter("order_id", "line_number", "unit_price", "line_total")
+	rows := e.Rows(testExportOrders(t, `<Order><OrderID>9</OrderID></Order>`))
+	if len(rows) != 1 || *rows[0][0] != "9" || *rows[0][1] != "1" || rows[0][2] != nil || rows[0][3] != nil {
+		t.Fatalf("unexpected rows %v", rows)
+	}
+}
+
+func TestOrderExporterErrors(t *testing.T) {
+	if _, err := NewOrderExporter("order_id", "nope"); err == nil {
+		t.Fatal("expected error for an unknown column")
+	}
+	e, _ := NewOrderExporter("order_id", "order_id")
+	if err := e.Export(&bytes.Buffer{}, ExportCSV, nil); err == nil {
+		t.Fatal("expected error for duplicate columns")
+	}
+	e, _ = NewOrderExporter()
+	if err := e.Export(&bytes.Buffer{}, "xml", nil); err == nil {
+		t.Fatal("expected error for an unknown format")
+	}
+}
+
+func TestOrderExporterParquet(t *testing.T) {
+	e, _ := NewOrderExporter("order_id", "sku", "paid_time", "line_total")
+	var buf bytes.Buffer
+	if err := e.Export(&buf, ExportParquet, testExportOrders(t, testExportOrder)); err != nil {
+		t.Fatal(err)
+	}
+
+	rows := readParquet(t, buf.Bytes(), e.Header())
+	if len(rows) != 2 || *rows[1][1] != "V2" || rows[1][2] != nil || *rows[1][3] != "10.00 USD" {
+		t.Fatalf("unexpected rows %v", rows)
+	}
+}
+
+func TestExportOrdersPages(t *testing.T) {
+	var pages []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GetOrdersRequest
+		xml.NewDecoder(r.Body).Decode(&req)
+		page := req.Pagination.PageNumber
+		pages = append(pages, fmt.Sprint(page))
+		fmt.Fprintf(w, `<GetOrdersResponse><Ack>Success</Ack><HasMoreOrders>%v</HasMoreOrders>
+<PaginationResult><TotalNumberOfPages>2</TotalNumberOfPages></PaginationResult>
+<OrderArray>%s</OrderArray></GetOrdersResponse>`, page < 2, testExportOrder)
+	}))
+	defer srv.Close()
+
+	api := NewTradingAPI(&EbayClient{baseURL: srv.URL}, nil)
+	e, _ := NewOrderExporter("order_id", "sku")
+	var buf bytes.Buffer
+	n, err := api.ExportOrders(context.Background(), &GetOrdersRequest{NumberOfDays: 5}, e, ExportCSV, &buf)
+	if err != nil || n != 2 {
+		t.Fatalf("exported %d orders: %v", n, err)
+	}
+	if strings.Join(pages, ",") != "1,2" || strings.Count(buf.String(), "\n") != 5 {
+		t.Fatalf("pages %v\n%s", pages, buf.String())
+	}
+}
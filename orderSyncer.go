@@ -0,0 +1,210 @@
+package ebayapi
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"time"
+)
+
+// MaxOrderModTimeWindow is the longest ModTimeFrom/ModTimeTo range GetOrders accepts
+const MaxOrderModTimeWindow = 30 * 24 * time.Hour
+
+// OrderWatermark is the persisted position of an OrderSyncer
+type OrderWatermark struct {
+	// ModTime is the end of the last fully synced window
+	ModTime time.Time
+	// Seen holds the LastModifiedTime of orders yielded within the overlap, so they aren't yielded again
+	// unless they change
+	Seen map[string]time.Time
+}
+
+// OrderWatermarkStore persists the OrderSyncer watermark between runs
+type OrderWatermarkStore interface {
+	// LoadWatermark returns nil when no sync ran yet
+	LoadWatermark(ctx context.Context) (*OrderWatermark, error)
+	SaveWatermark(ctx context.Context, wm *OrderWatermark) error
+}
+
+// FileWatermarkStore stores the watermark as JSON file
+type FileWatermarkStore struct {
+	Path string
+}
+
+// LoadWatermark reads the watermark file, a missing file means no sync ran yet
+func (s FileWatermarkStore) LoadWatermark(ctx context.Context) (*OrderWatermark, error) {
+	data, err := ioutil.ReadFile(s.Path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var wm OrderWatermark
+	if err := json.Unmarshal(data, &wm); err != nil {
+		return nil, err
+	}
+	return &wm, nil
+}
+
+// SaveWatermark writes the watermark file atomically
+func (s FileWatermarkStore) SaveWatermark(ctx context.Context, wm *OrderWatermark) error {
+	data, err := json.Marshal(wm)
+	if err != nil {
+		return err
+	}
+
+	tmp := s.Path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.Path)
+}
+
+// OrderSyncFunc is called for every new or changed order. Returning an error stops the sync, the order is
+// yielded again on the next run
+type OrderSyncFunc func(ctx context.Context, order *Order) error
+
+// OrderSyncStats summarizes a Sync run
+type OrderSyncStats struct {
+	Windows   int
+	Fetched   int
+	Changed   int
+	Watermark time.Time
+}
+
+// OrderSyncer incrementally imports orders by modification time
+type OrderSyncer struct {
+	api   *TradingAPI
+	store OrderWatermarkStore
+
+	// Since is how far back the first sync starts
+	Since time.Duration
+	// Overlap is subtracted from the watermark to catch orders ebay indexes late
+	Overlap time.Duration
+	// Window is the ModTime range of a single GetOrders run, at most MaxOrderModTimeWindow
+	Window time.Duration
+	// OrderStatus filters the orders, OrderStatusAll by default
+	OrderStatus    string
+	EntriesPerPage int
+	// OutputSelector limits the returned fields, the fields needed for syncing are always added
+	OutputSelector OutputSelector
+}
+
+// NewOrderSyncer instantiates an OrderSyncer starting 30 days back with a 10 minute overlap
+func NewOrderSyncer(api *TradingAPI, store OrderWatermarkStore) *OrderSyncer {
+	return &OrderSyncer{
+		api:            api,
+		store:          store,
+		Since:          MaxOrderModTimeWindow,
+		Overlap:        10 * time.Minute,
+		Window:         MaxOrderModTimeWindow,
+		OrderStatus:    OrderStatusAll,
+		EntriesPerPage: 100,
+	}
+}
+
+// Sync yields the orders created or modified since the last run and advances the watermark after every
+// completed window, so an interrupted sync resumes where it stopped
+func (s *OrderSyncer) Sync(ctx context.Context, fn OrderSyncFunc) (OrderSyncStats, error) {
+	var stats OrderSyncStats
+
+	wm, err := s.store.LoadWatermark(ctx)
+	if err != nil {
+		return stats, err
+	}
+	now := time.Now().UTC()
+	if wm == nil {
+		wm = &OrderWatermark{ModTime: now.Add(-s.Since)}
+	}
+	if wm.Seen == nil {
+		wm.Seen = map[string]time.Time{}
+	}
+	stats.Watermark = wm.ModTime
+
+	window := s.Window
+	if window <= 0 || window > MaxOrderModTimeWindow {
+		window = MaxOrderModTimeWindow
+	}
+
+	for from := wm.ModTime.Add(-s.Overlap); from.Before(now); from = from.Add(window) {
+		to := from.Add(window)
+		if to.After(now) {
+			to = now
+		}
+
+		stats.Windows++
+		err := s.syncWindow(ctx, from, to, wm, &stats, fn)
+		if err != nil {
+			// Keep the orders yielded so far, the watermark itself stays at the last completed window
+			if saveErr := s.store.SaveWatermark(ctx, wm); saveErr != nil {
+				s.api.logError("FAILED to save order watermark: ", saveErr)
+			}
+			return stats, err
+		}
+
+		wm.ModTime = to
+		for id, mod := range wm.Seen {
+			if mod.Before(to.Add(-s.Overlap)) {
+				delete(wm.Seen, id)
+			}
+		}
+		if err := s.store.SaveWatermark(ctx, wm); err != nil {
+			return stats, err
+		}
+		stats.Watermark = wm.ModTime
+	}
+
+	return stats, nil
+}
+
+func (s *OrderSyncer) syncWindow(ctx context.Context, from, to time.Time, wm *OrderWatermark, stats *OrderSyncStats, fn OrderSyncFunc) error {
+	req := &GetOrdersRequest{
+		ModTimeFrom:  &from,
+		ModTimeTo:    &to,
+		OrderStatus:  s.OrderStatus,
+		DetailLevel:  []string{DetailLevelReturnAll},
+		SortingOrder: "Ascending",
+	}
+	if len(s.OutputSelector) > 0 {
+		req.OutputSelector = s.OutputSelector.Add(
+			SelectHasMoreOrders,
+			SelectPaginationResult,
+			SelectOrderID,
+			SelectOrderLastModifiedTime,
+		)
+	}
+
+	for page := 1; ; page++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		req.Pagination = &Pagination{EntriesPerPage: s.EntriesPerPage, PageNumber: page}
+		resp, err := s.api.GetOrdersPage(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		for i := range resp.OrderArray.Orders {
+			order := &resp.OrderArray.Orders[i]
+			stats.Fetched++
+
+			modified := order.CheckoutStatus.LastModifiedTime
+			if seen, ok := wm.Seen[order.OrderID]; ok && !modified.After(seen) {
+				continue
+			}
+
+			if err := fn(ctx, order); err != nil {
+				return err
+			}
+			wm.Seen[order.OrderID] = modified
+			stats.Changed++
+		}
+
+		if !resp.HasMoreOrders || page >= resp.PaginationResult.TotalNumberOfPages {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package ebayapi
+
+import (
+	"encoding/xml"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// DetailLevel values, most calls only return the fields selected by OutputSelector with ReturnAll
+const (
+	DetailLevelReturnAll             = "ReturnAll"
+	DetailLevelReturnHeaders         = "ReturnHeaders"
+	DetailLevelReturnSummary         = "ReturnSummary"
+	DetailLevelItemReturnAttributes  = "ItemReturnAttributes"
+	DetailLevelItemReturnDescription = "ItemReturnDescription"
+)
+
+// GetOrders field paths
+const (
+	SelectHasMoreOrders              = "HasMoreOrders"
+	SelectPageNumber                 = "PageNumber"
+	SelectPaginationResult           = "PaginationResult"
+	SelectOrder                      = "OrderArray.Order"
+	SelectOrderID                    = "OrderArray.Order.OrderID"
+	SelectOrderStatus                = "OrderArray.Order.OrderStatus"
+	SelectOrderBuyerUserID           = "OrderArray.Order.BuyerUserID"
+	SelectOrderCreatedTime           = "OrderArray.Order.CreatedTime"
+	SelectOrderPaidTime              = "OrderArray.Order.PaidTime"
+	SelectOrderShippedTime           = "OrderArray.Order.ShippedTime"
+	SelectOrderCheckoutStatus        = "OrderArray.Order.CheckoutStatus"
+	SelectOrderLastModifiedTime      = "OrderArray.Order.CheckoutStatus.LastModifiedTime"
+	SelectOrderBuyerCheckoutMessage  = "OrderArray.Order.BuyerCheckoutMessage"
+	SelectOrderPaymentReferenceID    = "OrderArray.Order.MonetaryDetails.Payments.Payment.ReferenceID"
+	SelectOrderMonetaryDetails       = "OrderArray.Order.MonetaryDetails"
+	SelectOrderSalesRecordNumber     = "OrderArray.Order.ShippingDetails.SellingManagerSalesRecordNumber"
+	SelectOrderShipmentTracking      = "OrderArray.Order.ShippingDetails.ShipmentTrackingDetails"
+	SelectOrderShippingAddress       = "OrderArray.Order.ShippingAddress"
+	SelectOrderShippingService       = "OrderArray.Order.ShippingServiceSelected"
+	SelectOrderSubtotal              = "OrderArray.Order.Subtotal"
+	SelectOrderTotal                 = "OrderArray.Order.Total"
+	SelectTransaction                = "OrderArray.Order.TransactionArray.Transaction"
+	SelectTransactionID              = "OrderArray.Order.TransactionArray.Transaction.TransactionID"
+	SelectTransactionOrderLineItemID = "OrderArray.Order.TransactionArray.Transaction.OrderLineItemID"
+	SelectTransactionBuyer           = "OrderArray.Order.TransactionArray.Transaction.Buyer"
+	SelectTransactionItemID          = "OrderArray.Order.TransactionArray.Transaction.Item.ItemID"
+	SelectTransactionSKU             = "OrderArray.Order.TransactionArray.Transaction.Item.SKU"
+	SelectTransactionVariation       = "OrderArray.Order.TransactionArray.Transaction.Variation"
+	SelectTransactionQuantity        = "OrderArray.Order.TransactionArray.Transaction.QuantityPurchased"
+	SelectTransactionPrice           = "OrderArray.Order.TransactionArray.Transaction.TransactionPrice"
+	SelectTransactionTaxes           = "OrderArray.Order.TransactionArray.Transaction.Taxes"
+	SelectTransactionCollectAndRemit = "OrderArray.Order.TransactionArray.Transaction.eBayCollectAndRemitTaxes"
+)
+
+// GetMyeBaySelling field paths
+const (
+	SelectActiveListPagination    = "ActiveList.PaginationResult"
+	SelectActiveItem              = "ActiveList.ItemArray.Item"
+	SelectActiveItemID            = "ActiveList.ItemArray.Item.ItemID"
+	SelectActiveItemSKU           = "ActiveList.ItemArray.Item.SKU"
+	SelectActiveItemTitle         = "ActiveList.ItemArray.Item.Title"
+	SelectActiveItemQuantity      = "ActiveList.ItemArray.Item.QuantityAvailable"
+	SelectActiveItemCurrentPrice  = "ActiveList.ItemArray.Item.SellingStatus.CurrentPrice"
+	SelectActiveItemVariations    = "ActiveList.ItemArray.Item.Variations"
+	SelectActiveItemListingDetail = "ActiveList.ItemArray.Item.ListingDetails"
+)
+
+// OutputSelector is an ordered set of response field paths. A path selects its element with everything below it
+type OutputSelector []string
+
+// NewOutputSelector returns the set of paths, dropping duplicates
+func NewOutputSelector(paths ...string) OutputSelector {
+	return OutputSelector{}.Add(paths...)
+}
+
+// Add returns the set with the paths appended, paths already selected are not repeated
+func (s OutputSelector) Add(paths ...string) OutputSelector {
+	result := append(OutputSelector{}, s...)
+	for _, p := range paths {
+		if p != "" && !result.Contains(p) {
+			result = append(result, p)
+		}
+	}
+
+	return result
+}
+
+// Merge returns the union of the sets
+func (s OutputSelector) Merge(others ...OutputSelector) OutputSelector {
+	result := s.Add()
+	for _, o := range others {
+		result = result.Add(o...)
+	}
+
+	return result
+}
+
+// Remove returns the set without the paths and the paths below them
+func (s OutputSelector) Remove(paths ...string) OutputSelector {
+	var result OutputSelector
+	for _, selected := range s {
+		removed := false
+		for _, p := range paths {
+			if selected == p || strings.HasPrefix(selected, p+".") {
+				removed = true
+				break
+			}
+		}
+		if !removed {
+			result = append(result, selected)
+		}
+	}
+
+	return result
+}
+
+// Contains reports whether the exact path is selected
+func (s OutputSelector) Contains(path string) bool {
+	for _, selected := range s {
+		if selected == path {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Prefix returns the paths nested below the given element path
+func (s OutputSelector) Prefix(prefix string) OutputSelector {
+	result := make(OutputSelector, 0, len(s))
+	for _, p := range s {
+		result = append(result, prefix+"."+p)
+	}
+
+	return result
+}
+
+// SelectorsFor derives the paths of the fields a struct declares from their xml names, e.g. a struct with
+// OrderID and Total fields yields ["OrderID", "Total"]. Nested structs select their leaf fields, elements
+// holding only chardata and attributes (such as Price) and time.Time are selected as a whole
+func SelectorsFor(v interface{}) OutputSelector {
+	t := reflect.TypeOf(v)
+	for t != nil && (t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice) {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	return NewOutputSelector(structSelectors(t, map[reflect.Type]bool{})...)
+}
+
+var (
+	timeType    = reflect.TypeOf(time.Time{})
+	xmlNameType = reflect.TypeOf(xml.Name{})
+)
+
+func structSelectors(t reflect.Type, visiting map[reflect.Type]bool) []string {
+	if visiting[t] {
+		return nil
+	}
+	visiting[t] = true
+	defer delete(visiting, t)
+
+	var paths []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, opts := f.Name, ""
+		if tag, ok := f.Tag.Lookup("xml"); ok {
+			if tag == "-" {
+				continue
+			}
+			parts := strings.SplitN(tag, ",", 2)
+			if parts[0] != "" {
+				name = strings.Replace(parts[0], ">", ".", -1)
+			}
+			if len(parts) == 2 {
+				opts = parts[1]
+			}
+		}
+		if f.PkgPath != "" && !f.Anonymous || f.Type == xmlNameType {
+			continue
+		}
+		if strings.Contains(opts, "attr") || strings.Contains(opts, "chardata") || strings.Contains(opts, "innerxml") ||
+			strings.Contains(opts, "any") || strings.Contains(opts, "comment") {
+			continue
+		}
+
+		ft := f.Type
+		for ft.Kind() == reflect.Ptr || ft.Kind() == reflect.Slice && ft.Elem().Kind() != reflect.Uint8 {
+			ft = ft.Elem()
+		}
+
+		if f.Anonymous && ft.Kind() == reflect.Struct && !hasNameTag(f) {
+			paths = append(paths, structSelectors(ft, visiting)...)
+			continue
+		}
+		if ft.Kind() != reflect.Struct || ft == timeType {
+			paths = append(paths, name)
+			continue
+		}
+
+		children := structSelectors(ft, visiting)
+		if len(children) == 0 {
+			paths = append(paths, name)
+			continue
+		}
+		for _, c := range children {
+			paths = append(paths, name+"."+c)
+		}
+	}
+
+	return paths
+}
+
+func hasNameTag(f reflect.StructField) bool {
+	tag := f.Tag.Get("xml")
+	return tag != "" && !strings.HasPrefix(tag, ",")
+}
@@ -0,0 +1,63 @@
+package ebayapi
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// TestOutputSelectorConstants checks every field path constant against the paths derived from the response
+// struct its const block documents, so renamed or mistyped paths fail here instead of selecting nothing
+func TestOutputSelectorConstants(t *testing.T) {
+	responses := map[string]OutputSelector{
+		"GetOrders":        SelectorsFor(GetOrdersResponse{}),
+		"GetMyeBaySelling": SelectorsFor(GetMyeBaySellingResponse{}),
+	}
+
+	file, err := parser.ParseFile(token.NewFileSet(), "outputSelector.go", nil, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checked := 0
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST || gen.Doc == nil || !strings.HasSuffix(strings.TrimSpace(gen.Doc.Text()), "field paths") {
+			continue
+		}
+		call := strings.Fields(gen.Doc.Text())[0]
+		derived, ok := responses[call]
+		if !ok {
+			t.Fatalf("no response struct for the %s field paths", call)
+		}
+
+		for _, spec := range gen.Specs {
+			value := spec.(*ast.ValueSpec)
+			path, err := strconv.Unquote(value.Values[0].(*ast.BasicLit).Value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			checked++
+			if !selectsAny(derived, path) {
+				t.Errorf("%s = %q matches no %s response field", value.Names[0].Name, path, call)
+			}
+		}
+	}
+	if checked == 0 {
+		t.Fatal("no field path constants found")
+	}
+}
+
+// selectsAny reports whether path is one of the derived paths or an element above one
+func selectsAny(derived OutputSelector, path string) bool {
+	for _, p := range derived {
+		if p == path || strings.HasPrefix(p, path+".") {
+			return true
+		}
+	}
+
+	return false
+}
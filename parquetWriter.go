@@ -0,0 +1,246 @@
+package ebayapi
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+//
+// Minimal Parquet writer for flat tables of optional UTF8 columns. Rows are buffered and written on Close as a
+// single row group with one uncompressed, PLAIN encoded data page per column. Metadata uses the Thrift compact
+// protocol, see https://github.com/apache/parquet-format
+//
+
+const parquetMagic = "PAR1"
+
+// Parquet and Thrift enum values used by the writer
+const (
+	parquetTypeByteArray      = 6
+	parquetRepetitionOptional = 1
+	parquetConvertedUTF8      = 0
+	parquetEncodingPlain      = 0
+	parquetEncodingRLE        = 3
+	parquetCodecUncompressed  = 0
+	parquetPageData           = 0
+
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// parquetWriter buffers rows of nullable strings, a nil value is written as null
+type parquetWriter struct {
+	w       io.Writer
+	columns []string
+	rows    [][]*string
+}
+
+func newParquetWriter(w io.Writer, columns []string) *parquetWriter {
+	return &parquetWriter{w: w, columns: columns}
+}
+
+// WriteRow buffers a row, values must match the columns
+func (p *parquetWriter) WriteRow(values []*string) error {
+	if len(values) != len(p.columns) {
+		return errors.New("ERROR[parquet]: row does not match columns")
+	}
+	p.rows = append(p.rows, values)
+	return nil
+}
+
+// Close writes the buffered rows and the file footer
+func (p *parquetWriter) Close() error {
+	type chunk struct {
+		offset int64
+		size   int64
+	}
+
+	var file bytes.Buffer
+	file.WriteString(parquetMagic)
+
+	chunks := make([]chunk, len(p.columns))
+	for c := range p.columns {
+		page := p.page(c)
+
+		header := &thriftWriter{}
+		header.structBegin()
+		header.i32(1, parquetPageData)
+		header.i32(2, int32(len(page)))
+		header.i32(3, int32(len(page)))
+		header.fieldStruct(5)
+		header.i32(1, int32(len(p.rows)))
+		header.i32(2, parquetEncodingPlain)
+		header.i32(3, parquetEncodingRLE)
+		header.i32(4, parquetEncodingRLE)
+		header.structEnd()
+		header.structEnd()
+
+		chunks[c] = chunk{offset: int64(file.Len()), size: int64(header.buf.Len() + len(page))}
+		file.Write(header.buf.Bytes())
+		file.Write(page)
+	}
+
+	meta := &thriftWriter{}
+	meta.structBegin()
+	meta.i32(1, 1)
+
+	meta.listBegin(2, thriftStruct, len(p.columns)+1)
+	meta.structBegin()
+	meta.binary(4, "schema")
+	meta.i32(5, int32(len(p.columns)))
+	meta.structEnd()
+	for _, name := range p.columns {
+		meta.structBegin()
+		meta.i32(1, parquetTypeByteArray)
+		meta.i32(3, parquetRepetitionOptional)
+		meta.binary(4, name)
+		meta.i32(6, parquetConvertedUTF8)
+		meta.structEnd()
+	}
+
+	meta.i64(3, int64(len(p.rows)))
+
+	var total int64
+	for _, c := range chunks {
+		total += c.size
+	}
+	meta.listBegin(4, thriftStruct, 1)
+	meta.structBegin()
+	meta.listBegin(1, thriftStruct, len(p.columns))
+	for c, name := range p.columns {
+		meta.structBegin()
+		meta.i64(2, chunks[c].offset)
+		meta.fieldStruct(3)
+		meta.i32(1, parquetTypeByteArray)
+		meta.listBegin(2, thriftI32, 2)
+		meta.varint(parquetEncodingPlain)
+		meta.varint(parquetEncodingRLE)
+		meta.listBegin(3, thriftBinary, 1)
+		meta.str(name)
+		meta.i32(4, parquetCodecUncompressed)
+		meta.i64(5, int64(len(p.rows)))
+		meta.i64(6, chunks[c].size)
+		meta.i64(7, chunks[c].size)
+		meta.i64(9, chunks[c].offset)
+		meta.structEnd()
+		meta.structEnd()
+	}
+	meta.i64(2, total)
+	meta.i64(3, int64(len(p.rows)))
+	meta.structEnd()
+
+	meta.binary(6, "ebayapi-go")
+	meta.structEnd()
+
+	file.Write(meta.buf.Bytes())
+	binary.Write(&file, binary.LittleEndian, uint32(meta.buf.Len()))
+	file.WriteString(parquetMagic)
+
+	_, err := p.w.Write(file.Bytes())
+	return err
+}
+
+// page encodes the definition levels and the non null values of column c
+func (p *parquetWriter) page(c int) []byte {
+	// Definition levels use the RLE hybrid encoding with bit width 1, one run per change of value
+	var levels bytes.Buffer
+	for start := 0; start < len(p.rows); {
+		defined := p.rows[start][c] != nil
+		end := start + 1
+		for end < len(p.rows) && (p.rows[end][c] != nil) == defined {
+			end++
+		}
+		levels.Write(binary.AppendUvarint(nil, uint64(end-start)<<1))
+		if defined {
+			levels.WriteByte(1)
+		} else {
+			levels.WriteByte(0)
+		}
+		start = end
+	}
+
+	var page bytes.Buffer
+	binary.Write(&page, binary.LittleEndian, uint32(levels.Len()))
+	page.Write(levels.Bytes())
+	for _, row := range p.rows {
+		if v := row[c]; v != nil {
+			binary.Write(&page, binary.LittleEndian, uint32(len(*v)))
+			page.WriteString(*v)
+		}
+	}
+
+	return page.Bytes()
+}
+
+// thriftWriter encodes structs with the Thrift compact protocol
+type thriftWriter struct {
+	buf   bytes.Buffer
+	last  int16
+	stack []int16
+}
+
+func (t *thriftWriter) varint(v int64) {
+	t.buf.Write(binary.AppendVarint(nil, v))
+}
+
+func (t *thriftWriter) str(s string) {
+	t.buf.Write(binary.AppendUvarint(nil, uint64(len(s))))
+	t.buf.WriteString(s)
+}
+
+func (t *thriftWriter) field(id int16, typ byte) {
+	if delta := id - t.last; delta > 0 && delta <= 15 {
+		t.buf.WriteByte(byte(delta)<<4 | typ)
+	} else {
+		t.buf.WriteByte(typ)
+		t.varint(int64(id))
+	}
+	t.last = id
+}
+
+func (t *thriftWriter) i32(id int16, v int32) {
+	t.field(id, thriftI32)
+	t.varint(int64(v))
+}
+
+func (t *thriftWriter) i64(id int16, v int64) {
+	t.field(id, thriftI64)
+	t.varint(v)
+}
+
+func (t *thriftWriter) binary(id int16, s string) {
+	t.field(id, thriftBinary)
+	t.str(s)
+}
+
+// listBegin writes a list header, the elements follow without field headers
+func (t *thriftWriter) listBegin(id int16, elemType byte, size int) {
+	t.field(id, thriftList)
+	if size < 15 {
+		t.buf.WriteByte(byte(size)<<4 | elemType)
+	} else {
+		t.buf.WriteByte(0xf0 | elemType)
+		t.buf.Write(binary.AppendUvarint(nil, uint64(size)))
+	}
+}
+
+// structBegin starts a top level struct or a list element
+func (t *thriftWriter) structBegin() {
+	t.stack = append(t.stack, t.last)
+	t.last = 0
+}
+
+func (t *thriftWriter) fieldStruct(id int16) {
+	t.field(id, thriftStruct)
+	t.structBegin()
+}
+
+func (t *thriftWriter) structEnd() {
+	t.buf.WriteByte(0)
+	t.last = t.stack[len(t.stack)-1]
+	t.stack = t.stack[:len(t.stack)-1]
+}
@@ -0,0 +1,312 @@
+package ebayapi
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// thriftReader decodes Thrift compact structs into maps of field id to value, lists become []interface{}
+type thriftReader struct {
+	r *bytes.Reader
+}
+
+func (t thriftReader) value(typ byte) (interface{}, error) {
+	switch typ {
+	case 1:
+		return true, nil
+	case 2:
+		return false, nil
+	case 3:
+		return t.r.ReadByte()
+	case 4, 5, 6:
+		return binary.ReadVarint(t.r)
+	case 8:
+		n, err := binary.ReadUvarint(t.r)
+		if err != nil || n > uint64(t.r.Len()) {
+			return nil, fmt.Errorf("bad binary length %d: %v", n, err)
+		}
+		b := make([]byte, n)
+		t.r.Read(b)
+		return string(b), nil
+	case 9:
+		header, err := t.r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		size := uint64(header >> 4)
+		if size == 15 {
+			if size, err = binary.ReadUvarint(t.r); err != nil {
+				return nil, err
+			}
+		}
+		var list []interface{}
+		for i := uint64(0); i < size; i++ {
+			v, err := t.value(header & 0x0f)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, v)
+		}
+		return list, nil
+	case 12:
+		return t.structValue()
+	}
+
+	return nil, fmt.Errorf("unexpected thrift type %d", typ)
+}
+
+func (t thriftReader) structValue() (map[int]interface{}, error) {
+	fields := map[int]interface{}{}
+	last := 0
+	for {
+		header, err := t.r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		if header == 0 {
+			return fields, nil
+		}
+
+		id := last + int(header>>4)
+		if header>>4 == 0 {
+			v, err := binary.ReadVarint(t.r)
+			if err != nil {
+				return nil, err
+			}
+			id = int(v)
+		}
+		last = id
+
+		if fields[id], err = t.value(header & 0x0f); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// expectFields fails when the struct fields differ from want, field values are compared with reflect.DeepEqual
+func expectFields(t *testing.T, name string, got map[int]interface{}, want map[int]interface{}) {
+	t.Helper()
+	for id, v := range want {
+		if !reflect.DeepEqual(got[id], v) {
+			t.Fatalf("%s field %d = %#v, want %#v", name, id, got[id], v)
+		}
+	}
+}
+
+// readParquet checks the file layout produced by parquetWriter and returns its rows
+func readParquet(t *testing.T, data []byte, columns []string) [][]*string {
+	t.Helper()
+	if len(data) < 12 || string(data[:4]) != parquetMagic || string(data[len(data)-4:]) != parquetMagic {
+		t.Fatal("missing PAR1 magic")
+	}
+	size := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	footer := data[len(data)-8-size : len(data)-8]
+	meta, err := thriftReader{bytes.NewReader(footer)}.structValue()
+	if err != nil {
+		t.Fatal("decoding footer: ", err)
+	}
+
+	schema := meta[2].([]interface{})
+	if len(schema) != len(columns)+1 {
+		t.Fatalf("schema has %d elements, want %d", len(schema), len(columns)+1)
+	}
+	expectFields(t, "schema root", schema[0].(map[int]interface{}), map[int]interface{}{4: "schema", 5: int64(len(columns))})
+	for i, name := range columns {
+		expectFields(t, "schema "+name, schema[i+1].(map[int]interface{}), map[int]interface{}{
+			1: int64(parquetTypeByteArray),
+			3: int64(parquetRepetitionOptional),
+			4: name,
+			6: int64(parquetConvertedUTF8),
+		})
+	}
+
+	numRows := meta[3].(int64)
+	expectFields(t, "file", meta, map[int]interface{}{1: int64(1), 6: "ebayapi-go"})
+	rowGroups := meta[4].([]interface{})
+	if len(rowGroups) != 1 {
+		t.Fatalf("%d row groups, want 1", len(rowGroups))
+	}
+	rowGroup := rowGroups[0].(map[int]interface{})
+	expectFields(t, "row group", rowGroup, map[int]interface{}{3: numRows})
+	chunks := rowGroup[1].([]interface{})
+	if len(chunks) != len(columns) {
+		t.Fatalf("%d column chunks, want %d", len(chunks), len(columns))
+	}
+
+	rows := make([][]*string, numRows)
+	for i := range rows {
+		rows[i] = make([]*string, len(columns))
+	}
+
+	offset := int64(len(parquetMagic))
+	for c, chunk := range chunks {
+		chunkMeta := chunk.(map[int]interface{})[3].(map[int]interface{})
+		expectFields(t, "column chunk", chunk.(map[int]interface{}), map[int]interface{}{2: offset})
+		expectFields(t, "column "+columns[c], chunkMeta, map[int]interface{}{
+			1: int64(parquetTypeByteArray),
+			2: []interface{}{int64(parquetEncodingPlain), int64(parquetEncodingRLE)},
+			3: []interface{}{columns[c]},
+			4: int64(parquetCodecUncompressed),
+			5: numRows,
+			9: offset,
+		})
+
+		r := bytes.NewReader(data[offset:])
+		header, err := thriftReader{r}.structValue()
+		if err != nil {
+			t.Fatal("decoding page header: ", err)
+		}
+		headerSize := int64(len(data[offset:]) - r.Len())
+		pageSize := header[3].(int64)
+		expectFields(t, "page header", header, map[int]interface{}{1: int64(parquetPageData), 2: pageSize})
+		expectFields(t, "data page header", header[5].(map[int]interface{}), map[int]interface{}{
+			1: numRows,
+			2: int64(parquetEncodingPlain),
+			3: int64(parquetEncodingRLE),
+			4: int64(parquetEncodingRLE),
+		})
+		expectFields(t, "column "+columns[c], chunkMeta, map[int]interface{}{6: headerSize + pageSize, 7: headerSize + pageSize})
+
+		page := data[offset+headerSize : offset+headerSize+pageSize]
+		levelsSize := binary.LittleEndian.Uint32(page)
+		levels := bytes.NewReader(page[4 : 4+levelsSize])
+		values := bytes.NewReader(page[4+levelsSize:])
+		row := 0
+		for levels.Len() > 0 {
+			run, _ := binary.ReadUvarint(levels)
+			if run&1 != 0 {
+				t.Fatal("unexpected bit packed definition levels")
+			}
+			level, _ := levels.ReadByte()
+			for n := uint64(0); n < run>>1; n++ {
+				if level == 1 {
+					var length uint32
+					binary.Read(values, binary.LittleEndian, &length)
+					v := make([]byte, length)
+					values.Read(v)
+					s := string(v)
+					rows[row][c] = &s
+				}
+				row++
+			}
+		}
+		if int64(row) != numRows || values.Len() != 0 {
+			t.Fatalf("column %s holds %d levels and %d trailing bytes", columns[c], row, values.Len())
+		}
+
+		offset += headerSize + pageSize
+	}
+	if offset != int64(len(data)-8-size) {
+		t.Fatalf("footer starts at %d, pages end at %d", len(data)-8-size, offset)
+	}
+
+	return rows
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestParquetWriterRoundTrip(t *testing.T) {
+	// More than 14 columns exercise the long list header
+	var columns []string
+	for i := 0; i < 20; i++ {
+		columns = append(columns, fmt.Sprintf("column_%d", i))
+	}
+
+	var rows [][]*string
+	for r := 0; r < 50; r++ {
+		row := make([]*string, len(columns))
+		for c := range row {
+			switch {
+			case (r+c)%7 == 0:
+				// null
+			case c == 3:
+				row[c] = strPtr("")
+			default:
+				row[c] = strPtr(fmt.Sprintf("r%dc%d ünïcode %s", r, c, strings.Repeat("x", r)))
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	var buf bytes.Buffer
+	w := newParquetWriter(&buf, columns)
+	for _, row := range rows {
+		if err := w.WriteRow(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := readParquet(t, buf.Bytes(), columns); !reflect.DeepEqual(got, rows) {
+		t.Fatal("rows read back differ from rows written")
+	}
+}
+
+func TestParquetWriterEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	if err := newParquetWriter(&buf, []string{"a", "b"}).Close(); err != nil {
+		t.Fatal(err)
+	}
+	if rows := readParquet(t, buf.Bytes(), []string{"a", "b"}); len(rows) != 0 {
+		t.Fatalf("%d rows, want 0", len(rows))
+	}
+}
+
+func TestParquetWriterRowLength(t *testing.T) {
+	if err := newParquetWriter(&bytes.Buffer{}, []string{"a", "b"}).WriteRow([]*string{nil}); err == nil {
+		t.Fatal("expected error for a row not matching the columns")
+	}
+}
+
+// TestParquetWriterGolden compares a single cell file with bytes assembled by hand from the parquet-format
+// Thrift definitions
+func TestParquetWriterGolden(t *testing.T) {
+	golden := strings.Join([]string{
+		"50415231", // PAR1
+		// PageHeader: type DATA_PAGE, uncompressed and compressed size 11
+		"1500" + "1516" + "1516",
+		// DataPageHeader: 1 value, PLAIN, RLE definition and repetition levels
+		"2c" + "1502" + "1500" + "1506" + "1506" + "00" + "00",
+		// Page: 2 bytes of definition levels, a run of 1 defined value, then "x" PLAIN encoded
+		"02000000" + "0201" + "01000000" + "78",
+		// FileMetaData: version 1
+		"1502",
+		// schema list of 2 structs: root with 1 child, optional UTF8 byte array "a"
+		"192c" + "4806736368656d61" + "1502" + "00",
+		"150c" + "2502" + "180161" + "2500" + "00",
+		// num_rows 1
+		"1602",
+		// row_groups list of 1 struct with columns list of 1 ColumnChunk at file offset 4
+		"191c" + "191c" + "2608" + "1c",
+		// ColumnMetaData: BYTE_ARRAY, encodings PLAIN and RLE, path "a", UNCOMPRESSED, 1 value, sizes 28,
+		// data page offset 4
+		"150c" + "19250006" + "19180161" + "1500" + "1602" + "1638" + "1638" + "2608" + "00" + "00",
+		// total_byte_size 28, num_rows 1
+		"1638" + "1602" + "00",
+		// created_by
+		"280a" + hex.EncodeToString([]byte("ebayapi-go")) + "00",
+	}, "")
+	// The footer is what follows the magic, the 17 byte page header and the 11 byte page
+	footerSize := len(golden)/2 - 4 - 17 - 11
+	golden += fmt.Sprintf("%02x000000", footerSize) + "50415231"
+
+	var buf bytes.Buffer
+	w := newParquetWriter(&buf, []string{"a"})
+	w.WriteRow([]*string{strPtr("x")})
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := hex.EncodeToString(buf.Bytes()); got != golden {
+		t.Fatalf("parquet bytes\n got %s\nwant %s", got, golden)
+	}
+}
@@ -0,0 +1,216 @@
+package ebayapi
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+//
+// Trading API Platform Notifications are pushed by ebay as SOAP envelopes to the delivery URL configured
+// for the application. The body of each envelope is a regular Trading API response (GetItemResponse,
+// GetItemTransactionsResponse, ...) with the NotificationEventName added.
+//
+
+// Platform notification event names
+const (
+	NotificationItemListed              = "ItemListed"
+	NotificationItemRevised             = "ItemRevised"
+	NotificationItemClosed              = "ItemClosed"
+	NotificationItemSold                = "ItemSold"
+	NotificationItemUnsold              = "ItemUnsold"
+	NotificationFixedPriceTransaction   = "FixedPriceTransaction"
+	NotificationAuctionCheckoutComplete = "AuctionCheckoutComplete"
+	NotificationEndOfAuction            = "EndOfAuction"
+)
+
+// DefaultNotificationMaxAge is the allowed clock drift between a notification timestamp and now
+const DefaultNotificationMaxAge = 10 * time.Minute
+
+// MaxNotificationSize is the largest notification body accepted, larger requests are rejected unread
+const MaxNotificationSize = 4 << 20
+
+// PlatformNotification is the decoded body of a Trading API platform notification
+type PlatformNotification struct {
+	GetItemResponse
+	XMLName               xml.Name
+	NotificationEventName string
+	RecipientUserID       string
+	EIASToken             string
+	TransactionArray      struct {
+		Transactions []Transaction `xml:"Transaction"`
+	} `xml:"TransactionArray"`
+
+	// Signature holds the NotificationSignature from the SOAP header
+	Signature string `xml:"-"`
+}
+
+// PlatformNotificationFunc is called for every valid notification of the event it is registered for
+type PlatformNotificationFunc func(ctx context.Context, n *PlatformNotification) error
+
+type notificationEnvelope struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Header  struct {
+		RequesterCredentials struct {
+			NotificationSignature string `xml:"NotificationSignature"`
+		} `xml:"RequesterCredentials"`
+	} `xml:"Header"`
+	Body struct {
+		Notification PlatformNotification `xml:",any"`
+	} `xml:"Body"`
+}
+
+// notificationTimestamp keeps the timestamp exactly as sent, as the signature is computed over the raw string
+type notificationTimestamp struct {
+	Body struct {
+		Notification struct {
+			Timestamp string
+		} `xml:",any"`
+	} `xml:"Body"`
+}
+
+// PlatformNotificationHandler is an http.Handler receiving Trading API platform notifications
+type PlatformNotificationHandler struct {
+	creds  Credentials
+	logger *logrus.Logger
+
+	// MaxAge rejects notifications whose timestamp is further than this from the current time
+	MaxAge time.Duration
+
+	mu       sync.RWMutex
+	handlers map[string]PlatformNotificationFunc
+	fallback PlatformNotificationFunc
+	now      func() time.Time
+}
+
+// NewPlatformNotificationHandler instantiates a handler validating signatures against the client credentials
+func NewPlatformNotificationHandler(cli *EbayClient, l *logrus.Logger) *PlatformNotificationHandler {
+	return &PlatformNotificationHandler{
+		creds:    cli.Credentials,
+		logger:   l,
+		MaxAge:   DefaultNotificationMaxAge,
+		handlers: map[string]PlatformNotificationFunc{},
+		now:      time.Now,
+	}
+}
+
+// Handle registers the callback for a notification event name, e.g. NotificationItemSold
+func (h *PlatformNotificationHandler) Handle(event string, fn PlatformNotificationFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.handlers[event] = fn
+}
+
+// HandleDefault registers the callback for events without a dedicated handler
+func (h *PlatformNotificationHandler) HandleDefault(fn PlatformNotificationFunc) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.fallback = fn
+}
+
+// ParseNotification decodes and validates a SOAP notification envelope
+func (h *PlatformNotificationHandler) ParseNotification(data []byte) (*PlatformNotification, error) {
+	var env notificationEnvelope
+	if err := xml.Unmarshal(data, &env); err != nil {
+		return nil, err
+	}
+	var ts notificationTimestamp
+	if err := xml.Unmarshal(data, &ts); err != nil {
+		return nil, err
+	}
+
+	n := env.Body.Notification
+	n.Signature = env.Header.RequesterCredentials.NotificationSignature
+	if n.NotificationEventName == "" {
+		return nil, errors.New("ERROR[PlatformNotification]: NotificationEventName missing")
+	}
+
+	if !h.validSignature(ts.Body.Notification.Timestamp, n.Signature) {
+		return nil, ErrInvalidNotificationSignature
+	}
+
+	if h.MaxAge > 0 {
+		age := h.now().Sub(n.Timestamp)
+		if age > h.MaxAge || age < -h.MaxAge {
+			return nil, ErrStaleNotification
+		}
+	}
+
+	return &n, nil
+}
+
+// validSignature compares the signature against base64(MD5(Timestamp+DevID+AppID+CertID))
+func (h *PlatformNotificationHandler) validSignature(timestamp, signature string) bool {
+	if timestamp == "" || signature == "" {
+		return false
+	}
+	sum := md5.Sum([]byte(timestamp + h.creds.DevID + h.creds.AppID + h.creds.CertID))
+	expected := base64.StdEncoding.EncodeToString(sum[:])
+
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(signature)) == 1
+}
+
+// ServeHTTP parses the notification and dispatches it to the registered callback
+func (h *PlatformNotificationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, MaxNotificationSize))
+	if err != nil {
+		h.logError("FAILED to read platform notification: ", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	n, err := h.ParseNotification(body)
+	if err == ErrInvalidNotificationSignature || err == ErrStaleNotification {
+		h.logError("REJECTED platform notification: ", err)
+		w.WriteHeader(http.StatusForbidden)
+		return
+	} else if err != nil {
+		h.logError("FAILED to parse platform notification: ", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	h.mu.RLock()
+	fn, ok := h.handlers[n.NotificationEventName]
+	if !ok {
+		fn = h.fallback
+	}
+	h.mu.RUnlock()
+
+	if fn == nil {
+		if h.logger != nil {
+			h.logger.Debug("UNHANDLED platform notification: ", n.NotificationEventName)
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	// A non-200 status makes ebay retry delivery later
+	if err := fn(r.Context(), n); err != nil {
+		h.logError("FAILED to handle platform notification "+n.NotificationEventName+": ", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *PlatformNotificationHandler) logError(msg string, err error) {
+	if h.logger != nil {
+		h.logger.Error(msg, err.Error())
+	}
+}
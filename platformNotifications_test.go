@@ -0,0 +1,114 @@
+package ebayapi
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testNotificationTimestamp = "2024-05-01T10:00:00.000Z"
+
+func testNotificationHandler() *PlatformNotificationHandler {
+	cli := NewProductionClient(nil)
+	cli.DevID, cli.AppID, cli.CertID = "dev", "app", "cert"
+	h := NewPlatformNotificationHandler(cli, nil)
+	h.now = func() time.Time {
+		now, _ := time.Parse(time.RFC3339, testNotificationTimestamp)
+		return now
+	}
+
+	return h
+}
+
+func testNotification(signature string) string {
+	if signature == "" {
+		sum := md5.Sum([]byte(testNotificationTimestamp + "dev" + "app" + "cert"))
+		signature = base64.StdEncoding.EncodeToString(sum[:])
+	}
+
+	return `<?xml version="1.0" encoding="UTF-8"?>
+<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/">
+<soapenv:Header><ebl:RequesterCredentials xmlns:ebl="urn:ebay:apis:eBLBaseComponents">
+<ebl:NotificationSignature>` + signature + `</ebl:NotificationSignature>
+</ebl:RequesterCredentials></soapenv:Header>
+<soapenv:Body><GetItemTransactionsResponse xmlns="urn:ebay:apis:eBLBaseComponents">
+<Timestamp>` + testNotificationTimestamp + `</Timestamp><Ack>Success</Ack>
+<NotificationEventName>ItemSold</NotificationEventName><RecipientUserID>seller</RecipientUserID>
+<Item><ItemID>123</ItemID></Item>
+<TransactionArray><Transaction><TransactionID>9</TransactionID></Transaction></TransactionArray>
+</GetItemTransactionsResponse></soapenv:Body></soapenv:Envelope>`
+}
+
+func postNotification(h http.Handler, body string) int {
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/notifications", strings.NewReader(body)))
+	return rec.Code
+}
+
+func TestPlatformNotificationHandlerAccepts(t *testing.T) {
+	h := testNotificationHandler()
+	var got *PlatformNotification
+	h.Handle(NotificationItemSold, func(ctx context.Context, n *PlatformNotification) error {
+		got = n
+		return nil
+	})
+
+	if code := postNotification(h, testNotification("")); code != http.StatusOK {
+		t.Fatalf("status %d, want 200", code)
+	}
+	if got == nil || got.Item.ItemID != "123" || got.RecipientUserID != "seller" {
+		t.Fatalf("unexpected notification %+v", got)
+	}
+	if len(got.TransactionArray.Transactions) != 1 || got.TransactionArray.Transactions[0].TransactionID != "9" {
+		t.Fatalf("unexpected transactions %+v", got.TransactionArray.Transactions)
+	}
+}
+
+func TestPlatformNotificationHandlerRejects(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		now  string
+		want int
+	}{
+		{"bad signature", testNotification("bm90IHRoZSBzaWduYXR1cmU="), testNotificationTimestamp, http.StatusForbidden},
+		{"stale", testNotification(""), "2024-05-01T10:11:00Z", http.StatusForbidden},
+		{"future", testNotification(""), "2024-05-01T09:49:00Z", http.StatusForbidden},
+		{"within max age", testNotification(""), "2024-05-01T10:09:00Z", http.StatusOK},
+		{"malformed", "<soapenv:Envelope", testNotificationTimestamp, http.StatusBadRequest},
+		{"too large", testNotification("") + strings.Repeat(" ", MaxNotificationSize), testNotificationTimestamp, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := testNotificationHandler()
+			now, _ := time.Parse(time.RFC3339, tt.now)
+			h.now = func() time.Time { return now }
+			called := false
+			h.HandleDefault(func(ctx context.Context, n *PlatformNotification) error {
+				called = true
+				return nil
+			})
+
+			if code := postNotification(h, tt.body); code != tt.want {
+				t.Fatalf("status %d, want %d", code, tt.want)
+			}
+			if called != (tt.want == http.StatusOK) {
+				t.Fatalf("handler called %v", called)
+			}
+		})
+	}
+}
+
+func TestPlatformNotificationHandlerMethod(t *testing.T) {
+	rec := httptest.NewRecorder()
+	testNotificationHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/notifications", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("status %d, want 405", rec.Code)
+	}
+}
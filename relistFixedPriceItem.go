@@ -0,0 +1,44 @@
+package ebayapi
+
+import "encoding/xml"
+
+// RelistFixedPriceItemRequest struct - Item must carry the ItemID or SKU of the ended listing and only the fields to change
+type RelistFixedPriceItemRequest struct {
+	XMLName              xml.Name
+	RequesterCredentials *RequesterCredentials
+	Item                 *Item
+	DeletedField         []string `xml:"DeletedField,omitempty"`
+	ErrorLanguage        string   `xml:",omitempty"`
+	MessageID            string   `xml:",omitempty"`
+	Version              string   `xml:",omitempty"`
+	WarningLevel         string   `xml:",omitempty"`
+}
+
+// CallName returns name of call
+func (rq RelistFixedPriceItemRequest) CallName() string {
+	return "RelistFixedPriceItem"
+}
+
+// Body ataches credential and returns XML body
+func (rq RelistFixedPriceItemRequest) Body(creds *Credentials) interface{} {
+	rq.XMLName = xml.Name{
+		Space: "urn:ebay:apis:eBLBaseComponents",
+		Local: rq.CallName(),
+	}
+	rq.RequesterCredentials = &RequesterCredentials{EBayAuthToken: creds.AuthToken}
+	return rq
+}
+
+// ParseResponse retruns response data as EbayResponse object
+func (rq RelistFixedPriceItemRequest) ParseResponse(resp []byte) (EbayResponse, error) {
+	var xmlResponse RelistFixedPriceItemResponse
+	err := xml.Unmarshal(resp, &xmlResponse)
+
+	return xmlResponse, err
+}
+
+// RelistFixedPriceItemResponse struct - ItemID is the ID of the new listing
+type RelistFixedPriceItemResponse struct {
+	RelistItemResponse
+	SKU string
+}
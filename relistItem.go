@@ -0,0 +1,84 @@
+package ebayapi
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// RelistItemRequest struct - Item must carry the ItemID of the ended listing and only the fields to change
+type RelistItemRequest struct {
+	XMLName              xml.Name
+	RequesterCredentials *RequesterCredentials
+	Item                 *Item
+	DeletedField         []string `xml:"DeletedField,omitempty"`
+	ErrorLanguage        string   `xml:",omitempty"`
+	MessageID            string   `xml:",omitempty"`
+	Version              string   `xml:",omitempty"`
+	WarningLevel         string   `xml:",omitempty"`
+}
+
+// CallName returns name of call
+func (rq RelistItemRequest) CallName() string {
+	return "RelistItem"
+}
+
+// Body ataches credential and returns XML body
+func (rq RelistItemRequest) Body(creds *Credentials) interface{} {
+	rq.XMLName = xml.Name{
+		Space: "urn:ebay:apis:eBLBaseComponents",
+		Local: rq.CallName(),
+	}
+	rq.RequesterCredentials = &RequesterCredentials{EBayAuthToken: creds.AuthToken}
+	return rq
+}
+
+// ParseResponse retruns response data as EbayResponse object
+func (rq RelistItemRequest) ParseResponse(resp []byte) (EbayResponse, error) {
+	var xmlResponse RelistItemResponse
+	err := xml.Unmarshal(resp, &xmlResponse)
+
+	return xmlResponse, err
+}
+
+// VerifyRelistItemRequest validates a RelistItem request and returns its fees without relisting
+type VerifyRelistItemRequest RelistItemRequest
+
+// CallName returns name of call
+func (rq VerifyRelistItemRequest) CallName() string {
+	return "VerifyRelistItem"
+}
+
+// Body ataches credential and returns XML body
+func (rq VerifyRelistItemRequest) Body(creds *Credentials) interface{} {
+	rq.XMLName = xml.Name{
+		Space: "urn:ebay:apis:eBLBaseComponents",
+		Local: rq.CallName(),
+	}
+	rq.RequesterCredentials = &RequesterCredentials{EBayAuthToken: creds.AuthToken}
+	return rq
+}
+
+// ParseResponse retruns response data as EbayResponse object
+func (rq VerifyRelistItemRequest) ParseResponse(resp []byte) (EbayResponse, error) {
+	var xmlResponse RelistItemResponse
+	err := xml.Unmarshal(resp, &xmlResponse)
+
+	return xmlResponse, err
+}
+
+// ResponseErrors returns errors
+func (rs RelistItemResponse) ResponseErrors() EbayErrors {
+	return rs.ebayResponse.Errors
+}
+
+// RelistItemResponse struct - ItemID is the ID of the new listing
+type RelistItemResponse struct {
+	ebayResponse
+	ItemID         string
+	StartTime      *time.Time
+	EndTime        *time.Time
+	CategoryID     string
+	Category2ID    string
+	DiscountReason []string
+	Fees           Fees
+}
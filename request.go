@@ -2,6 +2,7 @@ package ebayapi
 
 import (
 	"encoding/xml"
+	"io"
 )
 
 // Call - interface for API calls
@@ -11,6 +12,13 @@ type Call interface {
 	ParseResponse([]byte) (EbayResponse, error)
 }
 
+// MultipartCall is a Call sent as multipart/form-data with a binary attachment after the XML payload,
+// calls returning a nil attachment are sent as plain XML
+type MultipartCall interface {
+	Call
+	Attachment() (filename string, data io.Reader)
+}
+
 // RequesterCredentials holds auth token for API calls
 type RequesterCredentials struct {
 	EBayAuthToken string `xml:"eBayAuthToken"`
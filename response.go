@@ -22,10 +22,29 @@ func (r ebayResponse) ResponseErrors() EbayErrors {
 	return r.Errors
 }
 
+// Warnings returns the non-fatal errors ebay reported for a successful call
+func (r ebayResponse) Warnings() EbayErrors {
+	var warnings EbayErrors
+	for _, e := range r.Errors {
+		if e.SeverityCode == "Warning" {
+			warnings = append(warnings, e)
+		}
+	}
+
+	return warnings
+}
+
 type ebayResponseError struct {
 	ShortMessage        string
 	LongMessage         string
 	ErrorCode           int
 	SeverityCode        string
 	ErrorClassification string
+	ErrorParameters     []ErrorParameter
+}
+
+// ErrorParameter holds a value the error refers to, such as the offending SKU
+type ErrorParameter struct {
+	ParamID string `xml:"ParamID,attr"`
+	Value   string
 }
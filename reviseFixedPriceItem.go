@@ -1,16 +1,21 @@
 package ebayapi
 
-import "encoding/xml"
+import (
+	"encoding/xml"
+	"strings"
+	"time"
+)
 
-// ReviseFixedPriceItemRequest struct
+// ReviseFixedPriceItemRequest struct - zero values of Item are not sent, use ItemRevision to set them
 type ReviseFixedPriceItemRequest struct {
 	XMLName              xml.Name
 	RequesterCredentials *RequesterCredentials
 	Item                 *Item
-	ErrorLanguage        string `xml:",omitempty"`
-	MessageID            string `xml:",omitempty"`
-	Version              string `xml:",omitempty"`
-	WarningLevel         string `xml:",omitempty"`
+	DeletedField         []string `xml:",omitempty"`
+	ErrorLanguage        string   `xml:",omitempty"`
+	MessageID            string   `xml:",omitempty"`
+	Version              string   `xml:",omitempty"`
+	WarningLevel         string   `xml:",omitempty"`
 }
 
 // CallName returns name of call
@@ -44,4 +49,247 @@ func (rs ReviseFixedPriceItemResponse) ResponseErrors() EbayErrors {
 // ReviseFixedPriceItemResponse struct
 type ReviseFixedPriceItemResponse struct {
 	ebayResponse
+	ItemID         string
+	SKU            string
+	StartTime      *time.Time
+	EndTime        *time.Time
+	CategoryID     string
+	Category2ID    string
+	DiscountReason []string
+	Fees           Fees
+
+	// Unchanged is set by TradingAPI.Revise when ebay reported a revision error meaning nothing needed changing
+	Unchanged bool `xml:"-"`
+}
+
+//
+// ItemRevision builds a ReviseFixedPriceItem call with explicit set and clear operations. Unlike Item its
+// scalar fields are pointers, so zero values such as a quantity of 0 are sent when set.
+//
+
+// RevisedItem holds the fields set on an ItemRevision, nil fields are left unchanged by ebay
+type RevisedItem struct {
+	ItemID                string                 `xml:"ItemID,omitempty"`
+	SKU                   string                 `xml:"SKU,omitempty"`
+	BestOfferDetails      *RevisedBestOffer      `xml:"BestOfferDetails,omitempty"`
+	BuyItNowPrice         *Price                 `xml:"BuyItNowPrice,omitempty"`
+	ConditionDescription  *string                `xml:"ConditionDescription,omitempty"`
+	ConditionID           *int                   `xml:"ConditionID,omitempty"`
+	Description           *string                `xml:"Description,omitempty"`
+	DispatchTimeMax       *int                   `xml:"DispatchTimeMax,omitempty"`
+	ItemSpecifics         *NameValueListArray    `xml:"ItemSpecifics,omitempty"`
+	ListingDuration       *string                `xml:"ListingDuration,omitempty"`
+	Location              *string                `xml:"Location,omitempty"`
+	OutOfStockControl     *bool                  `xml:"OutOfStockControl,omitempty"`
+	PictureDetails        *PictureDetails        `xml:"PictureDetails,omitempty"`
+	PostalCode            *string                `xml:"PostalCode,omitempty"`
+	PrimaryCategory       *Category              `xml:"PrimaryCategory,omitempty"`
+	ProductListingDetails *ProductListingDetails `xml:"ProductListingDetails,omitempty"`
+	Quantity              *int                   `xml:"Quantity,omitempty"`
+	ReturnPolicy          *ReturnPolicy          `xml:"ReturnPolicy,omitempty"`
+	SecondaryCategory     *Category              `xml:"SecondaryCategory,omitempty"`
+	SellerProfiles        *SellerProfiles        `xml:"SellerProfiles,omitempty"`
+	ShippingDetails       *ShippingDetails       `xml:"ShippingDetails,omitempty"`
+	StartPrice            *Price                 `xml:"StartPrice,omitempty"`
+	SubTitle              *string                `xml:"SubTitle,omitempty"`
+	Title                 *string                `xml:"Title,omitempty"`
+	Variations            *Variations            `xml:"Variations,omitempty"`
+}
+
+// RevisedBestOffer type - BestOfferEnabled is a pointer so Best Offer can be disabled
+type RevisedBestOffer struct {
+	BestOfferEnabled *bool `xml:"BestOfferEnabled,omitempty"`
+}
+
+// ItemRevision is a ReviseFixedPriceItem call built field by field
+type ItemRevision struct {
+	Item         RevisedItem
+	DeletedField []string
+}
+
+// NewItemRevision starts a revision of the listing with the given ItemID
+func NewItemRevision(itemID string) *ItemRevision {
+	return &ItemRevision{Item: RevisedItem{ItemID: itemID}}
+}
+
+// NewItemRevisionBySKU starts a revision of the listing with the given SKU, InventoryTrackingMethod must be SKU
+func NewItemRevisionBySKU(sku string) *ItemRevision {
+	return &ItemRevision{Item: RevisedItem{SKU: sku}}
+}
+
+// SetTitle revises the title
+func (r *ItemRevision) SetTitle(title string) *ItemRevision {
+	r.Item.Title = &title
+	return r
+}
+
+// SetSubTitle revises the subtitle
+func (r *ItemRevision) SetSubTitle(subTitle string) *ItemRevision {
+	r.Item.SubTitle = &subTitle
+	return r
+}
+
+// SetDescription revises the description
+func (r *ItemRevision) SetDescription(description string) *ItemRevision {
+	r.Item.Description = &description
+	return r
+}
+
+// SetQuantity revises the quantity available, 0 is sent and requires OutOfStockControl to keep the listing alive
+func (r *ItemRevision) SetQuantity(quantity int) *ItemRevision {
+	r.Item.Quantity = &quantity
+	return r
+}
+
+// SetStartPrice revises the fixed price
+func (r *ItemRevision) SetStartPrice(price Price) *ItemRevision {
+	r.Item.StartPrice = &price
+	return r
+}
+
+// SetBuyItNowPrice revises the Buy It Now price
+func (r *ItemRevision) SetBuyItNowPrice(price Price) *ItemRevision {
+	r.Item.BuyItNowPrice = &price
+	return r
+}
+
+// SetCondition revises the condition ID, and the description when not empty. Remove a description with
+// ClearField("ConditionDescription")
+func (r *ItemRevision) SetCondition(conditionID int, description string) *ItemRevision {
+	r.Item.ConditionID = &conditionID
+	if description != "" {
+		r.Item.ConditionDescription = &description
+	}
+	return r
+}
+
+// SetDispatchTimeMax revises the handling time in days, 0 is same day handling
+func (r *ItemRevision) SetDispatchTimeMax(days int) *ItemRevision {
+	r.Item.DispatchTimeMax = &days
+	return r
+}
+
+// SetListingDuration revises the listing duration, e.g. GTC
+func (r *ItemRevision) SetListingDuration(duration string) *ItemRevision {
+	r.Item.ListingDuration = &duration
+	return r
+}
+
+// SetLocation revises the item location and postal code, empty values are left unchanged. Remove a postal
+// code with ClearField("PostalCode")
+func (r *ItemRevision) SetLocation(location, postalCode string) *ItemRevision {
+	if location != "" {
+		r.Item.Location = &location
+	}
+	if postalCode != "" {
+		r.Item.PostalCode = &postalCode
+	}
+	return r
+}
+
+// SetOutOfStockControl revises whether the listing stays alive at quantity 0
+func (r *ItemRevision) SetOutOfStockControl(enabled bool) *ItemRevision {
+	r.Item.OutOfStockControl = &enabled
+	return r
+}
+
+// SetBestOffer enables or disables Best Offer
+func (r *ItemRevision) SetBestOffer(enabled bool) *ItemRevision {
+	r.Item.BestOfferDetails = &RevisedBestOffer{BestOfferEnabled: &enabled}
+	return r
+}
+
+// SetPictureURLs replaces the listing pictures
+func (r *ItemRevision) SetPictureURLs(urls ...string) *ItemRevision {
+	r.Item.PictureDetails = &PictureDetails{PictureURL: urls}
+	return r
+}
+
+// SetItemSpecifics replaces the item specifics
+func (r *ItemRevision) SetItemSpecifics(specifics ...NameValueList) *ItemRevision {
+	r.Item.ItemSpecifics = &NameValueListArray{NameValueList: specifics}
+	return r
+}
+
+// SetPrimaryCategory revises the leaf category
+func (r *ItemRevision) SetPrimaryCategory(categoryID string) *ItemRevision {
+	r.Item.PrimaryCategory = &Category{CategoryID: categoryID}
+	return r
+}
+
+// SetShippingDetails replaces the shipping details
+func (r *ItemRevision) SetShippingDetails(details *ShippingDetails) *ItemRevision {
+	r.Item.ShippingDetails = details
+	return r
+}
+
+// SetReturnPolicy replaces the return policy
+func (r *ItemRevision) SetReturnPolicy(policy *ReturnPolicy) *ItemRevision {
+	r.Item.ReturnPolicy = policy
+	return r
+}
+
+// SetSellerProfiles revises the business policies
+func (r *ItemRevision) SetSellerProfiles(profiles *SellerProfiles) *ItemRevision {
+	r.Item.SellerProfiles = profiles
+	return r
+}
+
+// SetVariation adds or revises a variation, identified by SKU or VariationSpecifics. ebay requires
+// StartPrice and Quantity for each revised variation
+func (r *ItemRevision) SetVariation(v *Variation) *ItemRevision {
+	if r.Item.Variations == nil {
+		r.Item.Variations = &Variations{}
+	}
+	r.Item.Variations.Variation = append(r.Item.Variations.Variation, v)
+	return r
+}
+
+// DeleteVariation removes a variation without sales, identified by its VariationSpecifics
+func (r *ItemRevision) DeleteVariation(v *Variation) *ItemRevision {
+	deleted := *v
+	deleted.Delete = true
+	return r.SetVariation(&deleted)
+}
+
+// ClearField removes an optional field from the listing, e.g. "Item.SubTitle" or "SubTitle"
+func (r *ItemRevision) ClearField(path string) *ItemRevision {
+	if !strings.HasPrefix(path, "Item.") {
+		path = "Item." + path
+	}
+	r.DeletedField = append(r.DeletedField, path)
+	return r
+}
+
+type itemRevisionBody struct {
+	XMLName              xml.Name
+	RequesterCredentials *RequesterCredentials
+	Item                 RevisedItem
+	DeletedField         []string `xml:",omitempty"`
+}
+
+// CallName returns name of call
+func (r ItemRevision) CallName() string {
+	return "ReviseFixedPriceItem"
+}
+
+// Body ataches credential and returns XML body
+func (r ItemRevision) Body(creds *Credentials) interface{} {
+	return itemRevisionBody{
+		XMLName: xml.Name{
+			Space: "urn:ebay:apis:eBLBaseComponents",
+			Local: r.CallName(),
+		},
+		RequesterCredentials: &RequesterCredentials{EBayAuthToken: creds.AuthToken},
+		Item:                 r.Item,
+		DeletedField:         r.DeletedField,
+	}
+}
+
+// ParseResponse retruns response data as EbayResponse object
+func (r ItemRevision) ParseResponse(resp []byte) (EbayResponse, error) {
+	var xmlResponse ReviseFixedPriceItemResponse
+	err := xml.Unmarshal(resp, &xmlResponse)
+
+	return xmlResponse, err
 }
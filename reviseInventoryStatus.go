@@ -1,6 +1,9 @@
 package ebayapi
 
-import "encoding/xml"
+import (
+	"encoding/xml"
+	"errors"
+)
 
 // ReviseInventoryStatusRequest struct
 type ReviseInventoryStatusRequest struct {
@@ -13,12 +16,46 @@ type ReviseInventoryStatusRequest struct {
 	WarningLevel         string             `xml:"WarningLevel,omitempty"`
 }
 
-// InventoryStatus struct
+// MaxInventoryStatusPerCall is the number of updates ebay accepts in a single ReviseInventoryStatus call
+const MaxInventoryStatusPerCall = 4
+
+// InventoryStatus struct - identify the listing by ItemID, SKU or both for variations. Quantity and
+// StartPrice are only sent when set, so quantity-only and price-only updates leave the other unchanged
 type InventoryStatus struct {
-	ItemID     string  `xml:"ItemID"`
-	Quantity   int     `xml:"Quantity"`
-	SKU        string  `xml:"SKU"`
-	StartPrice float64 `xml:"StartPrice"`
+	ItemID     string   `xml:"ItemID,omitempty"`
+	Quantity   *int     `xml:"Quantity,omitempty"`
+	SKU        string   `xml:"SKU,omitempty"`
+	StartPrice *float64 `xml:"StartPrice,omitempty"`
+}
+
+// QuantityUpdate returns an InventoryStatus revising only the quantity
+func QuantityUpdate(itemID, sku string, quantity int) *InventoryStatus {
+	return &InventoryStatus{ItemID: itemID, SKU: sku, Quantity: &quantity}
+}
+
+// PriceUpdate returns an InventoryStatus revising only the price
+func PriceUpdate(itemID, sku string, price float64) *InventoryStatus {
+	return &InventoryStatus{ItemID: itemID, SKU: sku, StartPrice: &price}
+}
+
+// Validate checks the update identifies a listing and changes something
+func (s InventoryStatus) Validate() error {
+	if s.ItemID == "" && s.SKU == "" {
+		return errors.New("ERROR[ReviseInventoryStatus]: ItemID or SKU value missing")
+	}
+	if s.Quantity == nil && s.StartPrice == nil {
+		return errors.New("ERROR[ReviseInventoryStatus]: Quantity or StartPrice value missing")
+	}
+
+	return nil
+}
+
+// key identifies the revised listing, SKU when set as variations share their ItemID
+func (s InventoryStatus) key() string {
+	if s.SKU != "" {
+		return "SKU:" + s.SKU
+	}
+	return "ItemID:" + s.ItemID
 }
 
 // CallName returns name of call
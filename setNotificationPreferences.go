@@ -0,0 +1,119 @@
+package ebayapi
+
+import "encoding/xml"
+
+// Notification preference status values
+const (
+	StatusEnable  = "Enable"
+	StatusDisable = "Disable"
+)
+
+// SetNotificationPreferencesRequest type
+type SetNotificationPreferencesRequest struct {
+	XMLName                        xml.Name
+	RequesterCredentials           *RequesterCredentials
+	ApplicationDeliveryPreferences *ApplicationDeliveryPreferences `xml:"ApplicationDeliveryPreferences,omitempty"`
+	DeliveryURLName                string                          `xml:"DeliveryURLName,omitempty"`
+	EventProperty                  []*NotificationEventProperty    `xml:"EventProperty,omitempty"`
+	UserData                       *NotificationUserData           `xml:"UserData,omitempty"`
+	UserDeliveryPreferenceArray    *UserDeliveryPreferenceArray    `xml:"UserDeliveryPreferenceArray,omitempty"`
+	ErrorLanguage                  string                          `xml:"ErrorLanguage,omitempty"`
+	MessageID                      string                          `xml:"MessageID,omitempty"`
+	Version                        string                          `xml:"Version,omitempty"`
+	WarningLevel                   string                          `xml:"WarningLevel,omitempty"`
+}
+
+// ApplicationDeliveryPreferences type - application level delivery settings
+type ApplicationDeliveryPreferences struct {
+	AlertEmail         string                `xml:"AlertEmail,omitempty"`
+	AlertEnable        string                `xml:"AlertEnable,omitempty"`
+	ApplicationEnable  string                `xml:"ApplicationEnable,omitempty"`
+	ApplicationURL     string                `xml:"ApplicationURL,omitempty"`
+	DeliveryURLDetails []*DeliveryURLDetails `xml:"DeliveryURLDetails,omitempty"`
+	DeviceType         string                `xml:"DeviceType,omitempty"`
+	PayloadVersion     string                `xml:"PayloadVersion,omitempty"`
+}
+
+// DeliveryURLDetails type
+type DeliveryURLDetails struct {
+	DeliveryURL     string `xml:"DeliveryURL,omitempty"`
+	DeliveryURLName string `xml:"DeliveryURLName,omitempty"`
+	Status          string `xml:"Status,omitempty"`
+}
+
+// NotificationEventProperty type
+type NotificationEventProperty struct {
+	EventType string `xml:"EventType,omitempty"`
+	Name      string `xml:"Name,omitempty"`
+	Value     string `xml:"Value,omitempty"`
+}
+
+// NotificationUserData type
+type NotificationUserData struct {
+	ExternalUserData string `xml:"ExternalUserData,omitempty"`
+}
+
+// UserDeliveryPreferenceArray type - user level event enablement
+type UserDeliveryPreferenceArray struct {
+	NotificationEnable []*NotificationEnable `xml:"NotificationEnable,omitempty"`
+}
+
+// NotificationEnable type
+type NotificationEnable struct {
+	EventEnable string `xml:"EventEnable,omitempty"`
+	EventType   string `xml:"EventType,omitempty"`
+}
+
+// EnableEvents sets the user delivery preference of each event to Enable
+func (c *SetNotificationPreferencesRequest) EnableEvents(events ...string) *SetNotificationPreferencesRequest {
+	return c.setEvents(StatusEnable, events)
+}
+
+// DisableEvents sets the user delivery preference of each event to Disable
+func (c *SetNotificationPreferencesRequest) DisableEvents(events ...string) *SetNotificationPreferencesRequest {
+	return c.setEvents(StatusDisable, events)
+}
+
+func (c *SetNotificationPreferencesRequest) setEvents(status string, events []string) *SetNotificationPreferencesRequest {
+	if c.UserDeliveryPreferenceArray == nil {
+		c.UserDeliveryPreferenceArray = &UserDeliveryPreferenceArray{}
+	}
+	for _, event := range events {
+		c.UserDeliveryPreferenceArray.NotificationEnable = append(c.UserDeliveryPreferenceArray.NotificationEnable,
+			&NotificationEnable{EventEnable: status, EventType: event})
+	}
+	return c
+}
+
+// CallName returns name of call
+func (c SetNotificationPreferencesRequest) CallName() string {
+	return "SetNotificationPreferences"
+}
+
+// Body ataches credential and returns XML body
+func (c SetNotificationPreferencesRequest) Body(creds *Credentials) interface{} {
+	c.XMLName = xml.Name{
+		Space: "urn:ebay:apis:eBLBaseComponents",
+		Local: c.CallName(),
+	}
+	c.RequesterCredentials = &RequesterCredentials{EBayAuthToken: creds.AuthToken}
+	return c
+}
+
+// ParseResponse retruns response data as EbayResponse object
+func (c SetNotificationPreferencesRequest) ParseResponse(r []byte) (EbayResponse, error) {
+	var xmlResponse SetNotificationPreferencesResponse
+	err := xml.Unmarshal(r, &xmlResponse)
+
+	return xmlResponse, err
+}
+
+// ResponseErrors returns errors
+func (r SetNotificationPreferencesResponse) ResponseErrors() EbayErrors {
+	return r.ebayResponse.Errors
+}
+
+// SetNotificationPreferencesResponse type
+type SetNotificationPreferencesResponse struct {
+	ebayResponse
+}
@@ -0,0 +1,371 @@
+package ebayapi
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Tracking upload statuses written to the result CSV
+const (
+	TrackingUploaded  = "uploaded"
+	TrackingDuplicate = "duplicate"
+	TrackingInvalid   = "invalid"
+	TrackingFailed    = "failed"
+)
+
+// TrackingColumns maps the CSV header names to the tracking fields, ItemID and TransactionID are optional and
+// ship a row's package for that line item only
+type TrackingColumns struct {
+	OrderID        string
+	Carrier        string
+	TrackingNumber string
+	ShipDate       string
+	ItemID         string
+	TransactionID  string
+}
+
+// DefaultTrackingColumns are the header names used when no mapping is configured
+var DefaultTrackingColumns = TrackingColumns{
+	OrderID:        "order_id",
+	Carrier:        "carrier",
+	TrackingNumber: "tracking_number",
+	ShipDate:       "ship_date",
+	ItemID:         "item_id",
+	TransactionID:  "transaction_id",
+}
+
+// TrackingRow is a parsed CSV row, Err is set when the row is invalid
+type TrackingRow struct {
+	// Line is the CSV line number, the header being line 1
+	Line    int
+	OrderID string
+	Package Package
+	Err     error
+}
+
+// TrackingUploadResult is the outcome of a row
+type TrackingUploadResult struct {
+	TrackingRow
+	Status string
+}
+
+// TrackingUploader marks orders shipped from CSV files of tracking numbers
+type TrackingUploader struct {
+	api *TradingAPI
+
+	Columns TrackingColumns
+	// Comma is the CSV field delimiter
+	Comma rune
+	// DateLayouts are tried in order to parse ship dates
+	DateLayouts []string
+	// Concurrency is the number of orders uploaded at once
+	Concurrency int
+}
+
+// NewTrackingUploader instantiates an uploader for comma separated files with DefaultTrackingColumns
+func NewTrackingUploader(api *TradingAPI) *TrackingUploader {
+	return &TrackingUploader{
+		api:         api,
+		Columns:     DefaultTrackingColumns,
+		Comma:       ',',
+		DateLayouts: []string{"2006-01-02", time.RFC3339, "2006-01-02 15:04:05", "01/02/2006"},
+		Concurrency: 4,
+	}
+}
+
+// ParseCSV reads the rows of a CSV file with a header line. Invalid rows are returned with Err set, including
+// rows of an order mixing rows with and without item ID. The error is only set when the file itself can't be read
+func (u *TrackingUploader) ParseCSV(r io.Reader) ([]TrackingRow, error) {
+	reader := csv.NewReader(r)
+	reader.Comma = u.Comma
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	index := map[string]int{}
+	for i, name := range header {
+		index[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	column := func(name string) int {
+		if i, ok := index[strings.ToLower(name)]; ok && name != "" {
+			return i
+		}
+		return -1
+	}
+
+	cols := u.Columns
+	required := []string{cols.OrderID, cols.Carrier, cols.TrackingNumber}
+	for _, name := range required {
+		if column(name) < 0 {
+			return nil, errors.New("ERROR[TrackingUpload]: column missing " + name)
+		}
+	}
+
+	var rows []TrackingRow
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			checkLineItemRows(rows)
+			return rows, nil
+		} else if err != nil {
+			return rows, err
+		}
+
+		field := func(name string) string {
+			if i := column(name); i >= 0 && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		row := TrackingRow{
+			Line:    line,
+			OrderID: field(cols.OrderID),
+			Package: Package{
+				Carrier:        field(cols.Carrier),
+				TrackingNumber: normalizeTrackingNumber(field(cols.TrackingNumber)),
+			},
+		}
+		if itemID := field(cols.ItemID); itemID != "" {
+			row.Package.LineItems = []*LineItem{{ItemID: itemID, TransactionID: field(cols.TransactionID)}}
+		}
+		if date := field(cols.ShipDate); date != "" {
+			shipped, err := u.parseDate(date)
+			if err != nil {
+				row.Err = err
+			} else {
+				row.Package.ShippedTime = &shipped
+			}
+		}
+		if row.Err == nil {
+			row.Err = u.validateRow(row)
+		}
+
+		rows = append(rows, row)
+	}
+}
+
+func (u *TrackingUploader) parseDate(value string) (time.Time, error) {
+	for _, layout := range u.DateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, errors.New("ERROR[TrackingUpload]: invalid ship date " + value)
+}
+
+func (u *TrackingUploader) validateRow(row TrackingRow) error {
+	switch {
+	case row.OrderID == "":
+		return errors.New("ERROR[TrackingUpload]: order ID missing")
+	case row.Package.Carrier == "":
+		return errors.New("ERROR[TrackingUpload]: carrier missing")
+	case row.Package.TrackingNumber == "":
+		return errors.New("ERROR[TrackingUpload]: tracking number missing")
+	case row.Package.ShippedTime != nil && row.Package.ShippedTime.After(time.Now()):
+		return errors.New("ERROR[TrackingUpload]: ship date in the future")
+	case len(row.Package.LineItems) > 0 && row.Package.LineItems[0].TransactionID == "":
+		return errors.New("ERROR[TrackingUpload]: transaction ID missing for item " + row.Package.LineItems[0].ItemID)
+	case u.api.Details != nil && !u.api.Details.ValidCarrier(row.Package.Carrier):
+		return errors.New("ERROR[TrackingUpload]: unknown carrier " + row.Package.Carrier)
+	}
+
+	return nil
+}
+
+// checkLineItemRows invalidates the rows of an order disagreeing with its first valid row on listing a line
+// item, MarkShipped ships either the whole order or line items
+func checkLineItemRows(rows []TrackingRow) {
+	first := map[string]int{}
+	for i, row := range rows {
+		if row.Err != nil {
+			continue
+		}
+		j, ok := first[row.OrderID]
+		if !ok {
+			first[row.OrderID] = i
+			continue
+		}
+
+		switch other := rows[j]; {
+		case len(other.Package.LineItems) > 0 && len(row.Package.LineItems) == 0:
+			rows[i].Err = fmt.Errorf("ERROR[TrackingUpload]: item ID missing, line %d of order %s lists one", other.Line, row.OrderID)
+		case len(other.Package.LineItems) == 0 && len(row.Package.LineItems) > 0:
+			rows[i].Err = fmt.Errorf("ERROR[TrackingUpload]: item ID set, line %d of order %s ships the whole order", other.Line, row.OrderID)
+		}
+	}
+}
+
+// Upload marks the orders of the valid rows shipped with MarkShipped, rows of the same order are uploaded
+// together as a split shipment. Results are returned in row order
+func (u *TrackingUploader) Upload(ctx context.Context, rows []TrackingRow) []TrackingUploadResult {
+	results := make([]TrackingUploadResult, len(rows))
+	var orderIDs []string
+	byOrder := map[string][]int{}
+	for i, row := range rows {
+		results[i] = TrackingUploadResult{TrackingRow: row}
+		if row.Err != nil {
+			results[i].Status = TrackingInvalid
+			continue
+		}
+		if _, ok := byOrder[row.OrderID]; !ok {
+			orderIDs = append(orderIDs, row.OrderID)
+		}
+		byOrder[row.OrderID] = append(byOrder[row.OrderID], i)
+	}
+
+	concurrency := u.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var waitGroup sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for _, orderID := range orderIDs {
+		indexes := byOrder[orderID]
+
+		waitGroup.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				waitGroup.Done()
+			}()
+			u.uploadOrder(ctx, indexes, results)
+		}()
+	}
+	waitGroup.Wait()
+
+	return results
+}
+
+// uploadOrder marks one order shipped, each goroutine writes only the results of its own rows. Rows sharing
+// a tracking number, e.g. one row per line item of a parcel, are sent as one package and their outcome is
+// mapped back to every row
+func (u *TrackingUploader) uploadOrder(ctx context.Context, indexes []int, results []TrackingUploadResult) {
+	var packages []Package
+	byKey := map[string]int{}
+	for _, i := range indexes {
+		p := results[i].Package
+		j, ok := byKey[trackingKey(p)]
+		if !ok {
+			byKey[trackingKey(p)] = len(packages)
+			p.LineItems = append([]*LineItem(nil), p.LineItems...)
+			packages = append(packages, p)
+			continue
+		}
+		for _, li := range p.LineItems {
+			if !containsLineItem(packages[j].LineItems, li) {
+				packages[j].LineItems = append(packages[j].LineItems, li)
+			}
+		}
+	}
+
+	orderID := results[indexes[0]].OrderID
+	res, err := u.api.MarkShipped(ctx, orderID, packages)
+	if err != nil {
+		u.api.logError("FAILED to upload tracking for "+orderID+": ", err)
+	}
+
+	// A split shipment failing part way still reports the line items handled before the failure
+	for _, i := range indexes {
+		switch {
+		case res != nil && shippedIn(res.Duplicates, results[i].Package):
+			results[i].Status = TrackingDuplicate
+		case err == nil || res != nil && len(results[i].Package.LineItems) > 0 && shippedIn(res.Uploaded, results[i].Package):
+			results[i].Status = TrackingUploaded
+		default:
+			results[i].Status = TrackingFailed
+			results[i].Err = err
+		}
+	}
+}
+
+// trackingKey identifies a row's package the way MarkShipped does after normalizing it
+func trackingKey(p Package) string {
+	return Package{Carrier: strings.TrimSpace(p.Carrier), TrackingNumber: normalizeTrackingNumber(p.TrackingNumber)}.key()
+}
+
+// shippedIn reports whether packages hold the row's tracking number for the row's line item, or for the whole
+// order when the row has no line item
+func shippedIn(packages []Package, row Package) bool {
+	for _, p := range packages {
+		if trackingKey(p) != trackingKey(row) {
+			continue
+		}
+		if len(row.LineItems) == 0 {
+			return len(p.LineItems) == 0
+		}
+		for _, li := range row.LineItems {
+			if !containsLineItem(p.LineItems, li) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return false
+}
+
+// UploadCSV parses the CSV from r, uploads its rows and writes the result CSV to w
+func (u *TrackingUploader) UploadCSV(ctx context.Context, r io.Reader, w io.Writer) ([]TrackingUploadResult, error) {
+	rows, err := u.ParseCSV(r)
+	if err != nil {
+		return nil, err
+	}
+
+	results := u.Upload(ctx, rows)
+	return results, WriteTrackingResults(w, results)
+}
+
+// WriteTrackingResults writes a CSV of the row outcomes with the ebay error codes and messages of failures
+func WriteTrackingResults(w io.Writer, results []TrackingUploadResult) error {
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"line", "order_id", "carrier", "tracking_number", "status", "error_codes", "error"})
+
+	for _, res := range results {
+		var codes, message string
+		if res.Err != nil {
+			message = res.Err.Error()
+			if ebayErrs, ok := res.Err.(EbayErrors); ok {
+				var codeList, messages []string
+				for _, e := range ebayErrs.Errors() {
+					codeList = append(codeList, strconv.Itoa(e.ErrorCode))
+					messages = append(messages, e.LongMessage)
+				}
+				codes = strings.Join(codeList, ";")
+				message = strings.Join(messages, "; ")
+			}
+		}
+
+		err := writer.Write([]string{
+			strconv.Itoa(res.Line),
+			res.OrderID,
+			res.Package.Carrier,
+			res.Package.TrackingNumber,
+			res.Status,
+			codes,
+			message,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("ERROR[TrackingUpload]: writing results: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package ebayapi
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTrackingUploaderParseCSV(t *testing.T) {
+	u := NewTrackingUploader(NewTradingAPI(&EbayClient{}, nil))
+	u.Comma = ';'
+	u.Columns = TrackingColumns{OrderID: "Order", Carrier: "Carrier", TrackingNumber: "Tracking", ShipDate: "Shipped", ItemID: "Item", TransactionID: "Txn"}
+	in := "order;carrier;tracking;shipped;item;txn;ignored\n" +
+		"O1; UPS ;1z 999-1;2024-01-02;;;x\n" +
+		"O2;USPS;9400;01/03/2024;I1;T1\n" +
+		"O2;USPS;9401;;I2\n" +
+		";USPS;1;;;\n" +
+		"O3;USPS;2;2999-01-01;;\n" +
+		"O4;DHL;3;yesterday;;\n" +
+		"O5;DHL;\n"
+
+	rows, err := u.ParseCSV(strings.NewReader(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 7 {
+		t.Fatalf("%d rows, want 7", len(rows))
+	}
+
+	first := rows[0]
+	if first.Line != 2 || first.OrderID != "O1" || first.Package.Carrier != "UPS" || first.Package.TrackingNumber != "1Z9991" ||
+		!first.Package.ShippedTime.Equal(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)) || first.Package.LineItems != nil || first.Err != nil {
+		t.Fatalf("unexpected first row %+v", first)
+	}
+	if li := rows[1].Package.LineItems; rows[1].Err != nil || len(li) != 1 || li[0].ItemID != "I1" || li[0].TransactionID != "T1" {
+		t.Fatalf("unexpected line item row %+v", rows[1])
+	}
+
+	invalid := map[int]string{2: "transaction ID", 3: "order ID", 4: "future", 5: "ship date", 6: "tracking number"}
+	for i, want := range invalid {
+		if rows[i].Err == nil || !strings.Contains(rows[i].Err.Error(), want) {
+			t.Errorf("line %d: got %v, want an error about %s", rows[i].Line, rows[i].Err, want)
+		}
+	}
+
+	if _, err := u.ParseCSV(strings.NewReader("order;carrier\nO1;UPS\n")); err == nil {
+		t.Fatal("expected error for a missing column")
+	}
+}
+
+func TestTrackingUploaderMixedLineItems(t *testing.T) {
+	u := NewTrackingUploader(NewTradingAPI(&EbayClient{}, nil))
+	in := "order_id,carrier,tracking_number,item_id,transaction_id\n" +
+		"O1,UPS,1,I1,T1\n" +
+		"O1,UPS,2,,\n" +
+		"O2,UPS,3,,\n" +
+		"O2,UPS,4,I2,T2\n" +
+		"O3,UPS,5,I3,T3\n" +
+		"O3,UPS,6,I4,T4\n"
+
+	rows, err := u.ParseCSV(strings.NewReader(in))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, want := range []string{"", "line 2 of order O1", "", "line 4 of order O2", "", ""} {
+		switch {
+		case want == "" && rows[i].Err != nil:
+			t.Errorf("line %d: unexpected error %v", rows[i].Line, rows[i].Err)
+		case want != "" && (rows[i].Err == nil || !strings.Contains(rows[i].Err.Error(), want)):
+			t.Errorf("line %d: got %v, want an error naming %s", rows[i].Line, rows[i].Err, want)
+		}
+	}
+}
+
+func TestTrackingUploaderUpload(t *testing.T) {
+	api, sales := testMarkShippedAPI(t, testOrderXML, func(req CompleteSaleRequest) string {
+		if req.ItemID == "I3" {
+			return `<CompleteSaleResponse><Ack>Failure</Ack><Errors><ErrorCode>21919303</ErrorCode><SeverityCode>Error</SeverityCode><LongMessage>Bad "item"</LongMessage></Errors></CompleteSaleResponse>`
+		}
+		return completeSaleSuccess(req)
+	})
+	u := NewTrackingUploader(api)
+	in := "order_id,carrier,tracking_number,ship_date,item_id,transaction_id\n" +
+		// Uploaded before for I1
+		"O1,UPS,1z-1,,I1,T1\n" +
+		// One parcel with I2 listed twice, uploaded before I3 fails
+		"O1,FedEx,77,,I2,T2\n" +
+		"O1,fedex,7 7,,I2,T2\n" +
+		"O1,FedEx,77,,I3,T3\n" +
+		",USPS,1,,,\n"
+
+	var out bytes.Buffer
+	results, err := u.UploadCSV(context.Background(), strings.NewReader(in), &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{TrackingDuplicate, TrackingUploaded, TrackingUploaded, TrackingFailed, TrackingInvalid}
+	for i, res := range results {
+		if res.Status != want[i] {
+			t.Errorf("line %d: status %s, want %s (%v)", res.Line, res.Status, want[i], res.Err)
+		}
+	}
+
+	// The repeated row must not send I2 twice
+	if len(*sales) != 2 || len((*sales)[0].Shipment.ShipmentTrackingDetails) != 1 {
+		t.Fatalf("unexpected CompleteSale calls %+v", *sales)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 6 || lines[0] != "line,order_id,carrier,tracking_number,status,error_codes,error" ||
+		lines[4] != `5,O1,FedEx,77,failed,21919303,"Bad ""item"""` {
+		t.Fatalf("unexpected result CSV\n%s", out.String())
+	}
+}
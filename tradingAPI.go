@@ -1,8 +1,13 @@
 package ebayapi
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
 	"sync"
 	"time"
 
@@ -14,6 +19,12 @@ import (
 type TradingAPI struct {
 	client *EbayClient
 	logger *logrus.Logger
+
+	// DryRun routes listing creation and RelistItem through the Verify* calls, returning fees without listing.
+	// RelistFixedPriceItem has no Verify call and fails with ErrDryRunUnsupported instead
+	DryRun bool
+	// Details optionally holds GeteBayDetails reference data used to validate carriers before CompleteSale
+	Details *EbayDetails
 }
 
 // NewTradingAPI instantiates and configures Trading obj
@@ -26,6 +37,10 @@ func NewTradingAPI(cli *EbayClient, l *logrus.Logger) *TradingAPI {
 
 // GetItem gets single item
 func (api *TradingAPI) GetItem(ctx context.Context, req *GetItemRequest) (*GetItemResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
 	response, err := api.client.DoSOAPcall(ctx, req)
 	if err != nil {
 		return nil, err
@@ -34,8 +49,21 @@ func (api *TradingAPI) GetItem(ctx context.Context, req *GetItemRequest) (*GetIt
 	return &resp, nil
 }
 
+// GetItemBySKU gets single item listed with InventoryTrackingMethod SKU, including its item specifics
+func (api *TradingAPI) GetItemBySKU(ctx context.Context, sku string) (*GetItemResponse, error) {
+	return api.GetItem(ctx, &GetItemRequest{
+		SKU:                  sku,
+		IncludeItemSpecifics: true,
+		DetailLevel:          []string{DetailLevelReturnAll},
+	})
+}
+
 // GetOrders gets a selection of orders, including pagination
 func (api *TradingAPI) GetOrders(ctx context.Context, req *GetOrdersRequest) ([]Order, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
 	ords := []Order{}
 	response, err := api.client.DoSOAPcall(ctx, req)
 	if err != nil {
@@ -79,6 +107,20 @@ func (api *TradingAPI) GetOrders(ctx context.Context, req *GetOrdersRequest) ([]
 	return ords, nil
 }
 
+// GetOrdersPage gets the single page of orders selected by req.Pagination
+func (api *TradingAPI) GetOrdersPage(ctx context.Context, req *GetOrdersRequest) (*GetOrdersResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	response, err := api.client.DoSOAPcall(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := response.(GetOrdersResponse)
+	return &resp, nil
+}
+
 // GetMyeBaySellingPage gets data about our ebay listings - single page
 func (api *TradingAPI) GetMyeBaySellingPage(ctx context.Context, req *GetMyeBaySellingRequest) (*GetMyeBaySellingResponse, error) {
 	response, err := api.client.DoSOAPcall(ctx, req)
@@ -134,6 +176,25 @@ func (api *TradingAPI) GetMyeBaySellingAll(ctx context.Context, req *GetMyeBaySe
 	return items, nil
 }
 
+// Revise sends an ItemRevision. A response failing only with revision errors, meaning nothing needed
+// changing, is reported with Unchanged
+func (api *TradingAPI) Revise(ctx context.Context, rev *ItemRevision) (*ReviseFixedPriceItemResponse, error) {
+	if rev.Item.ItemID == "" && rev.Item.SKU == "" {
+		return nil, errors.New("ERROR[Revise]: ItemID or SKU value missing")
+	}
+
+	response, err := api.client.DoSOAPcall(ctx, rev)
+	if ebayErrs, ok := err.(EbayErrors); ok && ebayErrs.OnlyRevisionErrors() {
+		resp := response.(ReviseFixedPriceItemResponse)
+		resp.Unchanged = true
+		return &resp, nil
+	} else if err != nil {
+		return nil, err
+	}
+	resp := response.(ReviseFixedPriceItemResponse)
+	return &resp, nil
+}
+
 // ReviseFixedPriceItem gets data about our ebay listings - single page
 func (api *TradingAPI) ReviseFixedPriceItem(ctx context.Context, item *Item) (*ReviseFixedPriceItemResponse, error) {
 	req := &ReviseFixedPriceItemRequest{Item: item}
@@ -146,20 +207,16 @@ func (api *TradingAPI) ReviseFixedPriceItem(ctx context.Context, item *Item) (*R
 	return &resp, nil
 }
 
-// ReviseInventoryStatus gets data about our ebay listings - single page
+// ReviseInventoryStatus revises quantity and price of up to 4 listings, use BulkInventoryReviser for any number
 func (api *TradingAPI) ReviseInventoryStatus(ctx context.Context, invRevisions []*InventoryStatus) (*ReviseInventoryStatusResponse, error) {
-	req := &ReviseInventoryStatusRequest{}
-	for i, item := range invRevisions {
-		// Add revised item data to request, max 4
-		if i >= 4 {
-			break
-		}
+	if len(invRevisions) > MaxInventoryStatusPerCall {
+		return nil, errors.New("ERROR[ReviseInventoryStatus]: more than 4 InventoryStatus values in request")
+	}
 
-		if item.ItemID == "" {
-			return nil, errors.New("ERROR[ReviseInventoryStatus]: ItemID value missing")
-		}
-		if item.SKU == "" {
-			return nil, errors.New("ERROR[ReviseInventoryStatus]: SKU value missing")
+	req := &ReviseInventoryStatusRequest{}
+	for _, item := range invRevisions {
+		if err := item.Validate(); err != nil {
+			return nil, err
 		}
 
 		req.InventoryStatus = append(req.InventoryStatus, item)
@@ -173,8 +230,12 @@ func (api *TradingAPI) ReviseInventoryStatus(ctx context.Context, invRevisions [
 	return &resp, nil
 }
 
-// CompleteSale gets data about our ebay listings - single page
+// CompleteSale updates the paid and shipped status of an order or line item and uploads tracking details
 func (api *TradingAPI) CompleteSale(ctx context.Context, req *CompleteSaleRequest) (*CompleteSaleResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
 	response, err := api.client.DoSOAPcall(ctx, req)
 	if err != nil {
 		return nil, err
@@ -182,3 +243,407 @@ func (api *TradingAPI) CompleteSale(ctx context.Context, req *CompleteSaleReques
 	resp := response.(CompleteSaleResponse)
 	return &resp, nil
 }
+
+// SetNotificationPreferences configures application delivery URLs and user level event enablement
+func (api *TradingAPI) SetNotificationPreferences(ctx context.Context, req *SetNotificationPreferencesRequest) (*SetNotificationPreferencesResponse, error) {
+	response, err := api.client.DoSOAPcall(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := response.(SetNotificationPreferencesResponse)
+	return &resp, nil
+}
+
+// GetNotificationPreferences gets the notification settings of the given PreferenceLevel
+func (api *TradingAPI) GetNotificationPreferences(ctx context.Context, level string) (*GetNotificationPreferencesResponse, error) {
+	response, err := api.client.DoSOAPcall(ctx, &GetNotificationPreferencesRequest{PreferenceLevel: level})
+	if err != nil {
+		return nil, err
+	}
+	resp := response.(GetNotificationPreferencesResponse)
+	return &resp, nil
+}
+
+// GetNotificationsUsage gets notification delivery statistics and failures
+func (api *TradingAPI) GetNotificationsUsage(ctx context.Context, req *GetNotificationsUsageRequest) (*GetNotificationsUsageResponse, error) {
+	response, err := api.client.DoSOAPcall(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := response.(GetNotificationsUsageResponse)
+	return &resp, nil
+}
+
+// AddFixedPriceItem lists a fixed price item, or verifies it in DryRun mode
+func (api *TradingAPI) AddFixedPriceItem(ctx context.Context, item *Item) (*AddFixedPriceItemResponse, error) {
+	if api.DryRun {
+		return api.VerifyAddFixedPriceItem(ctx, item)
+	}
+
+	response, err := api.client.DoSOAPcall(ctx, &AddFixedPriceItemRequest{Item: item})
+	if err != nil {
+		return nil, err
+	}
+	resp := response.(AddFixedPriceItemResponse)
+	return &resp, nil
+}
+
+// VerifyAddFixedPriceItem validates a fixed price item and returns its listing fees
+func (api *TradingAPI) VerifyAddFixedPriceItem(ctx context.Context, item *Item) (*AddFixedPriceItemResponse, error) {
+	response, err := api.client.DoSOAPcall(ctx, &VerifyAddFixedPriceItemRequest{Item: item})
+	if err != nil {
+		return nil, err
+	}
+	resp := response.(AddFixedPriceItemResponse)
+	return &resp, nil
+}
+
+// AddItem lists an auction or single quantity item, or verifies it in DryRun mode
+func (api *TradingAPI) AddItem(ctx context.Context, item *Item) (*AddItemResponse, error) {
+	if api.DryRun {
+		return api.VerifyAddItem(ctx, item)
+	}
+
+	response, err := api.client.DoSOAPcall(ctx, &AddItemRequest{Item: item})
+	if err != nil {
+		return nil, err
+	}
+	resp := response.(AddItemResponse)
+	return &resp, nil
+}
+
+// VerifyAddItem validates an auction or single quantity item and returns its listing fees
+func (api *TradingAPI) VerifyAddItem(ctx context.Context, item *Item) (*AddItemResponse, error) {
+	response, err := api.client.DoSOAPcall(ctx, &VerifyAddItemRequest{Item: item})
+	if err != nil {
+		return nil, err
+	}
+	resp := response.(AddItemResponse)
+	return &resp, nil
+}
+
+// AddItems lists up to 5 items, use BulkLister for any number of items
+func (api *TradingAPI) AddItems(ctx context.Context, req *AddItemsRequest) (*AddItemsResponse, error) {
+	if len(req.AddItemRequestContainer) > MaxAddItemsPerCall {
+		return nil, errors.New("ERROR[AddItems]: more than 5 items in request")
+	}
+
+	response, err := api.client.DoSOAPcall(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := response.(AddItemsResponse)
+	return &resp, nil
+}
+
+func (api *TradingAPI) logError(msg string, err error) {
+	if api.logger != nil {
+		api.logger.Error(msg, err.Error())
+	}
+}
+
+// EndItem ends a listing early, a listing that already ended is reported with AlreadyEnded
+func (api *TradingAPI) EndItem(ctx context.Context, itemID string, reason EndReason) (*EndItemResponse, error) {
+	req := &EndItemRequest{ItemID: itemID, EndingReason: reason}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	response, err := api.client.DoSOAPcall(ctx, req)
+	if ebayErrs, ok := err.(EbayErrors); ok && ebayErrs.AlreadyEnded() {
+		resp := response.(EndItemResponse)
+		resp.AlreadyEnded = true
+		return &resp, nil
+	} else if err != nil {
+		return nil, err
+	}
+	resp := response.(EndItemResponse)
+	return &resp, nil
+}
+
+// EndFixedPriceItem ends a fixed price listing by ItemID or SKU, a listing that already ended is reported with AlreadyEnded
+func (api *TradingAPI) EndFixedPriceItem(ctx context.Context, req *EndFixedPriceItemRequest) (*EndFixedPriceItemResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	response, err := api.client.DoSOAPcall(ctx, req)
+	if ebayErrs, ok := err.(EbayErrors); ok && ebayErrs.AlreadyEnded() {
+		resp := response.(EndFixedPriceItemResponse)
+		resp.AlreadyEnded = true
+		return &resp, nil
+	} else if err != nil {
+		return nil, err
+	}
+	resp := response.(EndFixedPriceItemResponse)
+	return &resp, nil
+}
+
+// EndItems ends any number of listings, 10 per call, and returns a result per listing in input order.
+// Listings that already ended count as success
+func (api *TradingAPI) EndItems(ctx context.Context, items []*EndItemRequestContainer) ([]EndItemResult, error) {
+	for i, item := range items {
+		switch {
+		case item == nil:
+			return nil, fmt.Errorf("ERROR[EndItems]: item %d is nil", i)
+		case item.ItemID == "":
+			return nil, fmt.Errorf("ERROR[EndItems]: ItemID value missing for item %d", i)
+		case item.EndingReason == "":
+			return nil, fmt.Errorf("ERROR[EndItems]: EndingReason value missing for item %d", i)
+		}
+	}
+
+	results := make([]EndItemResult, len(items))
+	for start := 0; start < len(items); start += MaxEndItemsPerCall {
+		end := start + MaxEndItemsPerCall
+		if end > len(items) {
+			end = len(items)
+		}
+
+		req := &EndItemsRequest{}
+		for i := start; i < end; i++ {
+			container := *items[i]
+			container.MessageID = strconv.Itoa(i)
+			req.EndItemRequestContainer = append(req.EndItemRequestContainer, &container)
+			results[i].ItemID = container.ItemID
+		}
+
+		response, err := api.client.DoSOAPcall(ctx, req)
+		if _, ok := err.(EbayErrors); err != nil && !ok {
+			return results, err
+		}
+		resp := response.(EndItemsResponse)
+
+		containers := map[string]EndItemResponseContainer{}
+		for _, c := range resp.EndItemResponseContainer {
+			containers[c.CorrelationID] = c
+		}
+
+		for i := start; i < end; i++ {
+			c, ok := containers[strconv.Itoa(i)]
+			if !ok {
+				results[i].Err = err
+				if err == nil {
+					results[i].Err = errors.New("ERROR[EndItems]: no result for ItemID " + items[i].ItemID)
+				}
+				continue
+			}
+
+			results[i].EndTime = c.EndTime
+			ebayErrs := EbayErrors(c.Errors)
+			if ebayErrs.AlreadyEnded() {
+				results[i].AlreadyEnded = true
+			} else if len(ebayErrs.Errors()) > 0 {
+				results[i].Err = ebayErrs
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// RelistFixedPriceItem relists an ended fixed price listing with the given changes. There is no
+// VerifyRelistFixedPriceItem, so ErrDryRunUnsupported is returned in DryRun mode
+func (api *TradingAPI) RelistFixedPriceItem(ctx context.Context, req *RelistFixedPriceItemRequest) (*RelistFixedPriceItemResponse, error) {
+	if api.DryRun {
+		return nil, ErrDryRunUnsupported
+	}
+
+	response, err := api.client.DoSOAPcall(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := response.(RelistFixedPriceItemResponse)
+	return &resp, nil
+}
+
+// RelistItem relists an ended auction listing with the given changes, or verifies it in DryRun mode
+func (api *TradingAPI) RelistItem(ctx context.Context, req *RelistItemRequest) (*RelistItemResponse, error) {
+	if api.DryRun {
+		return api.VerifyRelistItem(ctx, req)
+	}
+
+	response, err := api.client.DoSOAPcall(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := response.(RelistItemResponse)
+	return &resp, nil
+}
+
+// VerifyRelistItem validates a relist request and returns its listing fees
+func (api *TradingAPI) VerifyRelistItem(ctx context.Context, req *RelistItemRequest) (*RelistItemResponse, error) {
+	verify := VerifyRelistItemRequest(*req)
+	response, err := api.client.DoSOAPcall(ctx, &verify)
+	if err != nil {
+		return nil, err
+	}
+	resp := response.(RelistItemResponse)
+	return &resp, nil
+}
+
+// RelistEnded fetches an ended listing, applies mutate to it and relists it with the call matching its
+// ListingType. deletedFields are sent as DeletedField paths, e.g. "Item.SubTitle". In DryRun mode auctions
+// are verified with VerifyRelistItem, fixed price listings fail with ErrDryRunUnsupported without being relisted
+func (api *TradingAPI) RelistEnded(ctx context.Context, itemID string, mutate func(*Item) error, deletedFields ...string) (*RelistItemResponse, error) {
+	current, err := api.GetItem(ctx, &GetItemRequest{
+		ItemID:               itemID,
+		IncludeItemSpecifics: true,
+		DetailLevel:          []string{DetailLevelReturnAll},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if current.Item.SellingStatus != nil && current.Item.SellingStatus.ListingStatus == "Active" {
+		return nil, errors.New("ERROR[RelistEnded]: listing " + itemID + " is still active")
+	}
+
+	item := current.Item.ForRelist()
+	if mutate != nil {
+		if err := mutate(item); err != nil {
+			return nil, err
+		}
+	}
+	item.ItemID = itemID
+
+	if item.ListingType == "FixedPriceItem" || item.ListingType == "StoresFixedPrice" {
+		resp, err := api.RelistFixedPriceItem(ctx, &RelistFixedPriceItemRequest{Item: item, DeletedField: deletedFields})
+		if err != nil {
+			return nil, err
+		}
+		return &resp.RelistItemResponse, nil
+	}
+
+	return api.RelistItem(ctx, &RelistItemRequest{Item: item, DeletedField: deletedFields})
+}
+
+// UploadPicture uploads image data to ebay picture services, the returned FullURL is valid until UseByDate
+func (api *TradingAPI) UploadPicture(ctx context.Context, name string, picture io.Reader) (*UploadSiteHostedPicturesResponse, error) {
+	data, err := ioutil.ReadAll(io.LimitReader(picture, MaxPictureSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, errors.New("ERROR[UploadPicture]: picture is empty")
+	}
+	if len(data) > MaxPictureSize {
+		return nil, errors.New("ERROR[UploadPicture]: picture exceeds 12MB")
+	}
+
+	return api.UploadSiteHostedPictures(ctx, &UploadSiteHostedPicturesRequest{
+		PictureName: name,
+		PictureSet:  "Supersize",
+		Picture:     bytes.NewReader(data),
+	})
+}
+
+// UploadPictureURL has ebay copy an externally hosted image to its picture services
+func (api *TradingAPI) UploadPictureURL(ctx context.Context, name, url string) (*UploadSiteHostedPicturesResponse, error) {
+	if url == "" {
+		return nil, errors.New("ERROR[UploadPictureURL]: ExternalPictureURL value missing")
+	}
+
+	return api.UploadSiteHostedPictures(ctx, &UploadSiteHostedPicturesRequest{
+		PictureName:        name,
+		PictureSet:         "Supersize",
+		ExternalPictureURL: url,
+	})
+}
+
+// UploadSiteHostedPictures sends the upload request, as multipart when it holds picture data
+func (api *TradingAPI) UploadSiteHostedPictures(ctx context.Context, req *UploadSiteHostedPicturesRequest) (*UploadSiteHostedPicturesResponse, error) {
+	response, err := api.client.DoSOAPcall(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := response.(UploadSiteHostedPicturesResponse)
+	return &resp, nil
+}
+
+// GetCategories gets the category hierarchy of a site, or only its CategoryVersion without DetailLevel
+func (api *TradingAPI) GetCategories(ctx context.Context, req *GetCategoriesRequest) (*GetCategoriesResponse, error) {
+	response, err := api.client.DoSOAPcall(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := response.(GetCategoriesResponse)
+	return &resp, nil
+}
+
+// GetSuggestedCategories gets the categories best matching the query keywords
+func (api *TradingAPI) GetSuggestedCategories(ctx context.Context, query string) (*GetSuggestedCategoriesResponse, error) {
+	response, err := api.client.DoSOAPcall(ctx, &GetSuggestedCategoriesRequest{Query: query})
+	if err != nil {
+		return nil, err
+	}
+	resp := response.(GetSuggestedCategoriesResponse)
+	return &resp, nil
+}
+
+// GetCategorySpecifics gets the required and recommended item specifics of categories
+func (api *TradingAPI) GetCategorySpecifics(ctx context.Context, req *GetCategorySpecificsRequest) (*GetCategorySpecificsResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	response, err := api.client.DoSOAPcall(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := response.(GetCategorySpecificsResponse)
+	return &resp, nil
+}
+
+// GetCategoryFeatures gets the listing features (conditions, variations, durations...) of categories
+func (api *TradingAPI) GetCategoryFeatures(ctx context.Context, req *GetCategoryFeaturesRequest) (*GetCategoryFeaturesResponse, error) {
+	response, err := api.client.DoSOAPcall(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := response.(GetCategoryFeaturesResponse)
+	return &resp, nil
+}
+
+// GeteBayDetails gets site reference data such as shipping services, carriers and return policy options
+func (api *TradingAPI) GeteBayDetails(ctx context.Context, req *GeteBayDetailsRequest) (*GeteBayDetailsResponse, error) {
+	response, err := api.client.DoSOAPcall(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := response.(GeteBayDetailsResponse)
+	return &resp, nil
+}
+
+// LeaveFeedback leaves feedback for the buyer of a line item
+func (api *TradingAPI) LeaveFeedback(ctx context.Context, req *LeaveFeedbackRequest) (*LeaveFeedbackResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	response, err := api.client.DoSOAPcall(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := response.(LeaveFeedbackResponse)
+	return &resp, nil
+}
+
+// GetFeedback gets feedback left or received by a user
+func (api *TradingAPI) GetFeedback(ctx context.Context, req *GetFeedbackRequest) (*GetFeedbackResponse, error) {
+	response, err := api.client.DoSOAPcall(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := response.(GetFeedbackResponse)
+	return &resp, nil
+}
+
+// GetItemsAwaitingFeedback gets a page of line items we haven't left feedback for yet
+func (api *TradingAPI) GetItemsAwaitingFeedback(ctx context.Context, req *GetItemsAwaitingFeedbackRequest) (*GetItemsAwaitingFeedbackResponse, error) {
+	response, err := api.client.DoSOAPcall(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := response.(GetItemsAwaitingFeedbackResponse)
+	return &resp, nil
+}
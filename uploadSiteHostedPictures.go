@@ -0,0 +1,91 @@
+package ebayapi
+
+import (
+	"encoding/xml"
+	"io"
+	"time"
+)
+
+// MaxPictureSize is the largest picture file ebay accepts, in bytes
+const MaxPictureSize = 12 * 1024 * 1024
+
+// UploadSiteHostedPicturesRequest struct - either Picture is uploaded as binary attachment or ebay copies ExternalPictureURL
+type UploadSiteHostedPicturesRequest struct {
+	XMLName              xml.Name
+	RequesterCredentials *RequesterCredentials
+	ExtensionInDays      int    `xml:"ExtensionInDays,omitempty"`
+	ExternalPictureURL   string `xml:"ExternalPictureURL,omitempty"`
+	PictureName          string `xml:"PictureName,omitempty"`
+	PictureSet           string `xml:"PictureSet,omitempty"`
+	PictureSystemVersion int    `xml:"PictureSystemVersion,omitempty"`
+	PictureUploadPolicy  string `xml:"PictureUploadPolicy,omitempty"`
+	ErrorLanguage        string `xml:"ErrorLanguage,omitempty"`
+	MessageID            string `xml:"MessageID,omitempty"`
+	Version              string `xml:"Version,omitempty"`
+	WarningLevel         string `xml:"WarningLevel,omitempty"`
+
+	// Picture is the image data sent as multipart attachment
+	Picture io.Reader `xml:"-"`
+}
+
+// CallName returns name of call
+func (c UploadSiteHostedPicturesRequest) CallName() string {
+	return "UploadSiteHostedPictures"
+}
+
+// Body ataches credential and returns XML body
+func (c UploadSiteHostedPicturesRequest) Body(creds *Credentials) interface{} {
+	c.XMLName = xml.Name{
+		Space: "urn:ebay:apis:eBLBaseComponents",
+		Local: c.CallName(),
+	}
+	c.RequesterCredentials = &RequesterCredentials{EBayAuthToken: creds.AuthToken}
+	return c
+}
+
+// Attachment returns the picture data for the multipart request
+func (c UploadSiteHostedPicturesRequest) Attachment() (string, io.Reader) {
+	name := c.PictureName
+	if name == "" {
+		name = "picture"
+	}
+	return name, c.Picture
+}
+
+// ParseResponse retruns response data as EbayResponse object
+func (c UploadSiteHostedPicturesRequest) ParseResponse(r []byte) (EbayResponse, error) {
+	var xmlResponse UploadSiteHostedPicturesResponse
+	err := xml.Unmarshal(r, &xmlResponse)
+
+	return xmlResponse, err
+}
+
+// ResponseErrors returns errors
+func (r UploadSiteHostedPicturesResponse) ResponseErrors() EbayErrors {
+	return r.ebayResponse.Errors
+}
+
+// UploadSiteHostedPicturesResponse struct
+type UploadSiteHostedPicturesResponse struct {
+	ebayResponse
+	SiteHostedPictureDetails SiteHostedPictureDetails
+}
+
+// SiteHostedPictureDetails struct - FullURL is used in Item.PictureDetails.PictureURL
+type SiteHostedPictureDetails struct {
+	BaseURL            string
+	ExternalPictureURL string
+	FullURL            string
+	PictureFormat      string
+	PictureName        string
+	PictureSet         string
+	PictureSetMember   []PictureSetMember
+	UseByDate          time.Time
+}
+
+// PictureSetMember struct
+type PictureSetMember struct {
+	MemberURL     string
+	PictureHeight int
+	PictureWidth  int
+}
@@ -27,3 +27,8 @@ func PrettPrintXML(data []byte) (string, error) {
 		}
 	}
 }
+
+// Bool returns a pointer to b, for optional bool fields where false must be sent
+func Bool(b bool) *bool {
+	return &b
+}
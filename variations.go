@@ -0,0 +1,265 @@
+package ebayapi
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+//
+// Multi-variation listings share one ItemID, each variation is identified by its SKU or by its
+// VariationSpecifics name/value pairs. Variation quantities can also be revised with ReviseInventoryStatus
+// by passing the ItemID together with the variation SKU, see QuantityUpdate.
+//
+
+// Variations type
+type Variations struct {
+	Pictures              []*VariationPictures `xml:"Pictures,omitempty"`
+	Variation             []*Variation         `xml:"Variation,omitempty"`
+	VariationSpecificsSet *NameValueListArray  `xml:"VariationSpecificsSet,omitempty"`
+	ModifyNameList        *ModifyNameArray     `xml:"ModifyNameList,omitempty"`
+}
+
+// Variation type - Quantity is always sent as ebay requires it for every listed or revised variation
+type Variation struct {
+	SKU                            string                          `xml:"SKU,omitempty"`
+	StartPrice                     *Price                          `xml:"StartPrice,omitempty"`
+	Quantity                       int                             `xml:"Quantity"`
+	VariationSpecifics             *NameValueListArray             `xml:"VariationSpecifics,omitempty"`
+	VariationProductListingDetails *VariationProductListingDetails `xml:"VariationProductListingDetails,omitempty"`
+	SellingStatus                  *SellingStatus                  `xml:"SellingStatus,omitempty"`
+	VariationTitle                 string                          `xml:"VariationTitle,omitempty"`
+	VariationViewItemURL           string                          `xml:"VariationViewItemURL,omitempty"`
+	WatchCount                     int64                           `xml:"WatchCount,omitempty"`
+	Delete                         bool                            `xml:"Delete,omitempty"`
+}
+
+// VariationProductListingDetails type - product identifiers of a single variation
+type VariationProductListingDetails struct {
+	EAN  string `xml:"EAN,omitempty"`
+	ISBN string `xml:"ISBN,omitempty"`
+	UPC  string `xml:"UPC,omitempty"`
+}
+
+// VariationPictures type - pictures per value of one variation specific, e.g. per Color
+type VariationPictures struct {
+	VariationSpecificName       string                         `xml:"VariationSpecificName"`
+	VariationSpecificPictureSet []*VariationSpecificPictureSet `xml:"VariationSpecificPictureSet,omitempty"`
+}
+
+// VariationSpecificPictureSet type
+type VariationSpecificPictureSet struct {
+	VariationSpecificValue string   `xml:"VariationSpecificValue"`
+	PictureURL             []string `xml:"PictureURL,omitempty"`
+	ExternalPictureURL     []string `xml:"ExternalPictureURL,omitempty"`
+}
+
+// ModifyNameArray type - renames variation specific names
+type ModifyNameArray struct {
+	ModifyName []*ModifyName `xml:"ModifyName,omitempty"`
+}
+
+// ModifyName type
+type ModifyName struct {
+	Name    string `xml:"Name"`
+	NewName string `xml:"NewName"`
+}
+
+// SpecificsKey identifies the variation by its specifics, independent of their order
+func (v Variation) SpecificsKey() string {
+	if v.VariationSpecifics == nil {
+		return ""
+	}
+
+	var pairs []string
+	for _, nv := range v.VariationSpecifics.NameValueList {
+		pairs = append(pairs, nv.Name+"="+strings.Join(nv.Value, "|"))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ";")
+}
+
+// Available returns the quantity left to sell. GetItem reports Quantity including sold units
+func (v Variation) Available() int {
+	if v.SellingStatus != nil {
+		return v.Quantity - v.SellingStatus.QuantitySold
+	}
+	return v.Quantity
+}
+
+// Find returns the variation matching v by SKU, or by specifics when either has no SKU. Variations without
+// specifics only match by SKU
+func (vs *Variations) Find(v *Variation) *Variation {
+	if vs == nil {
+		return nil
+	}
+	key := v.SpecificsKey()
+	for _, current := range vs.Variation {
+		if v.SKU != "" && current.SKU != "" {
+			if v.SKU == current.SKU {
+				return current
+			}
+			continue
+		}
+		if key != "" && key == current.SpecificsKey() {
+			return current
+		}
+	}
+
+	return nil
+}
+
+// VariationDiff holds the changes needed to turn the current variations of a listing into the desired ones
+type VariationDiff struct {
+	Added   []*Variation
+	Changed []*Variation
+	// Removed holds current variations missing from the desired set
+	Removed []*Variation
+	// SpecificsSet is set when added variations need values missing from the current VariationSpecificsSet
+	SpecificsSet *NameValueListArray
+	// Pictures is set when the desired variation pictures differ from the current ones
+	Pictures []*VariationPictures
+}
+
+// Empty reports whether the variations are already as desired
+func (d VariationDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Changed) == 0 && len(d.Removed) == 0 && d.SpecificsSet == nil && d.Pictures == nil
+}
+
+// DiffVariations compares the variations of a listing as returned by GetItem with the desired variations,
+// whose Quantity is the quantity available
+func DiffVariations(current, desired *Variations) VariationDiff {
+	var diff VariationDiff
+	if desired == nil {
+		desired = &Variations{}
+	}
+
+	for _, v := range desired.Variation {
+		cur := current.Find(v)
+		if cur == nil {
+			diff.Added = append(diff.Added, v)
+			continue
+		}
+		if v.Quantity != cur.Available() || !samePrice(v.StartPrice, cur.StartPrice) {
+			diff.Changed = append(diff.Changed, v)
+		}
+	}
+
+	if current != nil {
+		for _, cur := range current.Variation {
+			if desired.Find(cur) == nil {
+				diff.Removed = append(diff.Removed, cur)
+			}
+		}
+
+		if len(desired.Pictures) > 0 && !reflect.DeepEqual(desired.Pictures, current.Pictures) {
+			diff.Pictures = desired.Pictures
+		}
+	} else if len(desired.Pictures) > 0 {
+		diff.Pictures = desired.Pictures
+	}
+
+	if len(diff.Added) > 0 {
+		var currentSet *NameValueListArray
+		if current != nil {
+			currentSet = current.VariationSpecificsSet
+		}
+		set := mergeSpecificsSet(currentSet, desired.VariationSpecificsSet, diff.Added)
+		if !reflect.DeepEqual(set, currentSet) {
+			diff.SpecificsSet = set
+		}
+	}
+
+	return diff
+}
+
+// VariationRevision builds the minimal ReviseFixedPriceItem call turning the current variations of the
+// listing into the desired ones. Removed variations with sales cannot be deleted and are set to quantity 0
+func VariationRevision(itemID string, current, desired *Variations) (*ItemRevision, bool) {
+	diff := DiffVariations(current, desired)
+	if diff.Empty() {
+		return nil, false
+	}
+
+	rev := NewItemRevision(itemID)
+	for _, v := range diff.Added {
+		rev.SetVariation(revisedVariation(v, v.Quantity))
+	}
+	for _, v := range diff.Changed {
+		rev.SetVariation(revisedVariation(v, v.Quantity))
+	}
+	for _, v := range diff.Removed {
+		if v.SellingStatus != nil && v.SellingStatus.QuantitySold > 0 {
+			rev.SetVariation(revisedVariation(v, 0))
+		} else {
+			rev.DeleteVariation(revisedVariation(v, 0))
+		}
+	}
+
+	if rev.Item.Variations == nil {
+		rev.Item.Variations = &Variations{}
+	}
+	rev.Item.Variations.VariationSpecificsSet = diff.SpecificsSet
+	rev.Item.Variations.Pictures = diff.Pictures
+	return rev, true
+}
+
+// revisedVariation copies the identifying and revisable fields of v
+func revisedVariation(v *Variation, quantity int) *Variation {
+	return &Variation{
+		SKU:                            v.SKU,
+		StartPrice:                     v.StartPrice,
+		Quantity:                       quantity,
+		VariationSpecifics:             v.VariationSpecifics,
+		VariationProductListingDetails: v.VariationProductListingDetails,
+	}
+}
+
+func samePrice(a, b *Price) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Amount == b.Amount
+}
+
+// mergeSpecificsSet returns the union of the current set, the desired set and the values used by added variations
+func mergeSpecificsSet(current, desired *NameValueListArray, added []*Variation) *NameValueListArray {
+	merged := &NameValueListArray{}
+	add := func(name, value string) {
+		for n := range merged.NameValueList {
+			if merged.NameValueList[n].Name == name {
+				for _, existing := range merged.NameValueList[n].Value {
+					if existing == value {
+						return
+					}
+				}
+				merged.NameValueList[n].Value = append(merged.NameValueList[n].Value, value)
+				return
+			}
+		}
+		merged.NameValueList = append(merged.NameValueList, NameValueList{Name: name, Value: []string{value}})
+	}
+
+	for _, set := range []*NameValueListArray{current, desired} {
+		if set == nil {
+			continue
+		}
+		for _, nv := range set.NameValueList {
+			for _, value := range nv.Value {
+				add(nv.Name, value)
+			}
+		}
+	}
+	for _, v := range added {
+		if v.VariationSpecifics == nil {
+			continue
+		}
+		for _, nv := range v.VariationSpecifics.NameValueList {
+			for _, value := range nv.Value {
+				add(nv.Name, value)
+			}
+		}
+	}
+
+	return merged
+}